@@ -0,0 +1,223 @@
+package oci
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	oci_common "github.com/oracle/oci-go-sdk/v44/common"
+)
+
+// maximum number of latency samples kept per API call, older samples are replaced at random
+const apiCallLatencySamples = 1000
+
+// path segments which identify a resource, replaced in the operation name so calls are grouped per operation
+var apiCallResourceSegment = regexp.MustCompile(`/(ocid1\.[^/]+|[0-9]+)(/|$)`)
+
+// object storage names, e.g. /n/{namespace}/b/{bucket}/o/{object}
+var apiCallObjectStorageSegment = regexp.MustCompile(`/(n|b|o|p)/[^/]+`)
+
+// apiCallKey groups the API calls of a connection
+type apiCallKey struct {
+	Tenancy       string
+	Service       string
+	Operation     string
+	Region        string
+	CompartmentId string
+}
+
+// apiCallStats are the statistics of the API calls of a connection with the same apiCallKey
+type apiCallStats struct {
+	apiCallKey
+	Calls       int64
+	Errors      int64
+	Throttles   int64
+	Retries     int64
+	ErrorCodes  map[string]int64
+	FirstCallAt time.Time
+	LastCallAt  time.Time
+	latencies   []time.Duration
+	samples     int64
+}
+
+// apiCallRow is a snapshot of apiCallStats returned by the oci_plugin_api_call table
+type apiCallRow struct {
+	apiCallKey
+	Calls        int64
+	Errors       int64
+	Throttles    int64
+	Retries      int64
+	ErrorCodes   map[string]int64
+	LatencyP50Ms float64
+	LatencyP90Ms float64
+	LatencyP99Ms float64
+	LatencyMaxMs float64
+	FirstCallAt  time.Time
+	LastCallAt   time.Time
+}
+
+var apiCalls = map[string]map[apiCallKey]*apiCallStats{}
+var apiCallsLock sync.Mutex
+
+// recordApiCall adds a request sent by a service client of a connection to its statistics
+func recordApiCall(connection string, key apiCallKey, latency time.Duration, statusCode int, errorCode string, retryable bool) {
+	apiCallsLock.Lock()
+	defer apiCallsLock.Unlock()
+
+	if apiCalls[connection] == nil {
+		apiCalls[connection] = map[apiCallKey]*apiCallStats{}
+	}
+	stats, ok := apiCalls[connection][key]
+	if !ok {
+		stats = &apiCallStats{apiCallKey: key, ErrorCodes: map[string]int64{}, FirstCallAt: time.Now()}
+		apiCalls[connection][key] = stats
+	}
+
+	stats.Calls++
+	stats.LastCallAt = time.Now()
+	if errorCode != "" {
+		stats.Errors++
+		stats.ErrorCodes[errorCode]++
+	}
+	if statusCode == http.StatusTooManyRequests {
+		stats.Throttles++
+	}
+	// failed attempts the retry policy acts on are sent again
+	if retryable {
+		stats.Retries++
+	}
+
+	// reservoir sampling keeps the percentiles representative for long running sessions
+	stats.samples++
+	if len(stats.latencies) < apiCallLatencySamples {
+		stats.latencies = append(stats.latencies, latency)
+	} else if i := rand.Int63n(stats.samples); i < apiCallLatencySamples {
+		stats.latencies[i] = latency
+	}
+}
+
+// getApiCalls returns the API call statistics of a connection
+func getApiCalls(connection string) []apiCallRow {
+	apiCallsLock.Lock()
+	defer apiCallsLock.Unlock()
+
+	rows := []apiCallRow{}
+	for _, stats := range apiCalls[connection] {
+		latencies := append([]time.Duration{}, stats.latencies...)
+		sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+
+		errorCodes := map[string]int64{}
+		for code, count := range stats.ErrorCodes {
+			errorCodes[code] = count
+		}
+
+		rows = append(rows, apiCallRow{
+			apiCallKey:   stats.apiCallKey,
+			Calls:        stats.Calls,
+			Errors:       stats.Errors,
+			Throttles:    stats.Throttles,
+			Retries:      stats.Retries,
+			ErrorCodes:   errorCodes,
+			LatencyP50Ms: latencyPercentile(latencies, 50),
+			LatencyP90Ms: latencyPercentile(latencies, 90),
+			LatencyP99Ms: latencyPercentile(latencies, 99),
+			LatencyMaxMs: latencyPercentile(latencies, 100),
+			FirstCallAt:  stats.FirstCallAt,
+			LastCallAt:   stats.LastCallAt,
+		})
+	}
+	return rows
+}
+
+// latencyPercentile returns the nearest rank percentile of sorted latencies in milliseconds
+func latencyPercentile(latencies []time.Duration, percentile int) float64 {
+	if len(latencies) == 0 {
+		return 0
+	}
+	rank := (percentile*len(latencies) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+	return float64(latencies[rank-1].Microseconds()) / 1000
+}
+
+// getApiCallOperation returns the operation of a request, e.g. "GET /20160918/vcns/{id}"
+func getApiCallOperation(req *http.Request) string {
+	path := req.URL.Path
+	if strings.HasPrefix(path, "/n/") {
+		path = apiCallObjectStorageSegment.ReplaceAllString(path, "/$1/{name}")
+		return fmt.Sprintf("%s %s", req.Method, path)
+	}
+
+	// the first segment is the API version, which is kept
+	version := ""
+	if segments := strings.SplitN(path, "/", 3); len(segments) > 1 && segments[0] == "" {
+		version, path = "/"+segments[1], ""
+		if len(segments) == 3 {
+			path = "/" + segments[2]
+		}
+	}
+	// a replaced segment consumes the trailing slash, so repeat for consecutive ids
+	for previous := ""; previous != path; {
+		previous = path
+		path = apiCallResourceSegment.ReplaceAllString(path, "/{id}$2")
+	}
+	return fmt.Sprintf("%s %s%s", req.Method, version, path)
+}
+
+// apiCallRecorder records the statistics of every request sent by a service client
+type apiCallRecorder struct {
+	dispatcher oci_common.HTTPRequestDispatcher
+	connection string
+	tenancy    string
+	service    string
+	region     string
+}
+
+func (r apiCallRecorder) Do(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := r.dispatcher.Do(req)
+	latency := time.Since(start)
+
+	key := apiCallKey{
+		Tenancy:       r.tenancy,
+		Service:       r.service,
+		Operation:     getApiCallOperation(req),
+		Region:        r.region,
+		CompartmentId: req.URL.Query().Get("compartmentId"),
+	}
+
+	if err != nil {
+		recordApiCall(r.connection, key, latency, 0, getErrorCode(err), isRetryableNetworkError(err))
+		return resp, err
+	}
+
+	errorCode := ""
+	if resp.StatusCode >= 400 {
+		errorCode = strconv.Itoa(resp.StatusCode)
+		// read the service error code, and restore the body for the SDK
+		if body, readErr := io.ReadAll(resp.Body); readErr == nil {
+			resp.Body.Close()
+			resp.Body = io.NopCloser(bytes.NewReader(body))
+			var failure struct {
+				Code string `json:"code"`
+			}
+			if json.Unmarshal(body, &failure) == nil && failure.Code != "" {
+				errorCode = failure.Code
+			}
+		}
+	}
+	retryable := resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusInternalServerError || resp.StatusCode == http.StatusServiceUnavailable
+	recordApiCall(r.connection, key, latency, resp.StatusCode, errorCode, retryable)
+
+	return resp, nil
+}
@@ -0,0 +1,34 @@
+package oci
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestGetApiCallOperation(t *testing.T) {
+	tests := []struct {
+		method string
+		path   string
+		want   string
+	}{
+		{http.MethodGet, "/20160918/vcns", "GET /20160918/vcns"},
+		{http.MethodGet, "/20160918/vcns/ocid1.vcn.oc1.iad.aaaaaaaa1", "GET /20160918/vcns/{id}"},
+		{http.MethodGet, "/20160918/instances/ocid1.instance.oc1.iad.aaaaaaaa1/vnicAttachments", "GET /20160918/instances/{id}/vnicAttachments"},
+		{http.MethodGet, "/20180608/keys/ocid1.key.oc1.iad.aaaaaaaa1/keyVersions/ocid1.keyversion.oc1.iad.aaaaaaaa1", "GET /20180608/keys/{id}/keyVersions/{id}"},
+		{http.MethodGet, "/20160918/things/1/2", "GET /20160918/things/{id}/{id}"},
+		{http.MethodPost, "/20180401/metrics/actions/summarizeMetricsData", "POST /20180401/metrics/actions/summarizeMetricsData"},
+		{http.MethodGet, "/20160918", "GET /20160918"},
+		{http.MethodGet, "/n/namespace/b/bucket/o/object", "GET /n/{name}/b/{name}/o/{name}"},
+		{http.MethodGet, "/n/namespace/b", "GET /n/{name}/b"},
+	}
+
+	for _, test := range tests {
+		req, err := http.NewRequest(test.method, "https://example.com"+test.path, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := getApiCallOperation(req); got != test.want {
+			t.Errorf("getApiCallOperation(%s %s) = %s, want %s", test.method, test.path, got, test.want)
+		}
+	}
+}
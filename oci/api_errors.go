@@ -0,0 +1,120 @@
+package oci
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"syscall"
+	"time"
+
+	oci_common "github.com/oracle/oci-go-sdk/v44/common"
+)
+
+// apiError is an error returned by an OCI API call, with the service,
+// operation and region of the call
+type apiError struct {
+	Service   string
+	Operation string
+	Region    string
+	Err       error
+}
+
+func (e *apiError) Error() string {
+	return fmt.Sprintf("%s %s in %s: %s", e.Service, e.Operation, e.Region, e.Err.Error())
+}
+
+func (e *apiError) Unwrap() error {
+	return e.Err
+}
+
+// apiServiceError is an apiError for a failure response of the service. It
+// implements oci_common.ServiceError, so existing checks of the status code
+// keep working on wrapped errors.
+type apiServiceError struct {
+	apiError
+	serviceErr oci_common.ServiceError
+}
+
+func (e *apiServiceError) Error() string {
+	return fmt.Sprintf("%s %s in %s failed with %d %s (opc-request-id: %s): %s", e.Service, e.Operation, e.Region, e.serviceErr.GetHTTPStatusCode(), e.serviceErr.GetCode(), e.serviceErr.GetOpcRequestID(), e.serviceErr.GetMessage())
+}
+
+func (e *apiServiceError) GetHTTPStatusCode() int {
+	return e.serviceErr.GetHTTPStatusCode()
+}
+
+func (e *apiServiceError) GetMessage() string {
+	return e.serviceErr.GetMessage()
+}
+
+func (e *apiServiceError) GetCode() string {
+	return e.serviceErr.GetCode()
+}
+
+func (e *apiServiceError) GetOpcRequestID() string {
+	return e.serviceErr.GetOpcRequestID()
+}
+
+// wrapServiceError adds the service, operation and region of the session to an error returned by an API call
+func wrapServiceError(err error, sess *session, operation string) error {
+	if err == nil {
+		return nil
+	}
+
+	wrapped := apiError{Service: sess.Service, Operation: operation, Region: sess.Region, Err: err}
+	if serviceErr, ok := getServiceError(err); ok {
+		return &apiServiceError{apiError: wrapped, serviceErr: serviceErr}
+	}
+	return &wrapped
+}
+
+// getServiceError returns the service failure of an error, which may be wrapped
+func getServiceError(err error) (oci_common.ServiceError, bool) {
+	for ; err != nil; err = errors.Unwrap(err) {
+		if serviceErr, ok := oci_common.IsServiceError(err); ok {
+			return serviceErr, true
+		}
+		if serviceErr, ok := err.(*apiServiceError); ok {
+			return serviceErr, true
+		}
+	}
+	return nil, false
+}
+
+// isRetryableNetworkError returns true for connection resets and timeouts, but
+// not for requests cancelled by the caller
+func isRetryableNetworkError(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	if errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// getRetryAfter returns the delay requested by the Retry-After header of a response, if any
+func getRetryAfter(r oci_common.OCIOperationResponse) (time.Duration, bool) {
+	if r.Response == nil || r.Response.HTTPResponse() == nil {
+		return 0, false
+	}
+	retryAfter := r.Response.HTTPResponse().Header.Get("Retry-After")
+	if retryAfter == "" {
+		return 0, false
+	}
+	// either a number of seconds or an HTTP date
+	if seconds, err := strconv.Atoi(retryAfter); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := time.Parse(time.RFC1123, retryAfter); err == nil {
+		if delay := time.Until(date); delay > 0 {
+			return delay, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
@@ -0,0 +1,286 @@
+package oci
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	oci_common "github.com/oracle/oci-go-sdk/v44/common"
+)
+
+const (
+	cassetteModeRecord = "record"
+	cassetteModeReplay = "replay"
+)
+
+// value written in place of redacted headers and secrets
+const cassetteRedacted = "REDACTED"
+
+// headers holding credentials, which are never written to a cassette
+var cassetteRedactedHeaders = []string{"Authorization", "Cookie", "Set-Cookie", "Opc-Obo-Token", "X-Subject-Token"}
+
+// lower case JSON keys holding secrets, which are redacted from recorded bodies
+var cassetteRedactedKeys = []string{"password", "passphrase", "privatekey", "token", "securitytoken", "secretbundlecontent"}
+
+// JSON keys of request bodies which change on every run, e.g. the time range
+// of metric queries ending now, and are not part of the interaction file name
+var cassetteVolatileKeys = []string{"startTime", "endTime"}
+
+// cassetteInteraction is a request and its response, stored as one file of the cassette directory
+type cassetteInteraction struct {
+	Request  cassetteRequest  `json:"request"`
+	Response cassetteResponse `json:"response"`
+}
+
+type cassetteRequest struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Header http.Header `json:"header"`
+	Body   string      `json:"body,omitempty"`
+}
+
+type cassetteResponse struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header"`
+	Body       string      `json:"body,omitempty"`
+}
+
+/*
+The `cassette_mode` and `cassette_dir` connection options record the API calls
+of a connection, or replay recorded calls instead of sending them:
+
+	cassette_mode = "record"
+	cassette_dir  = "~/oci-cassettes/issue-123"
+
+Each interaction is stored in a file of the service directory named after the
+method, URL and body of the request. The time range of the body is left out,
+so a query ending now replays the recording of an earlier run.
+*/
+func getCassette(config ociConfig) (mode string, dir string, err error) {
+	if config.CassetteMode == nil || *config.CassetteMode == "" {
+		return "", "", nil
+	}
+
+	mode = *config.CassetteMode
+	if mode != cassetteModeRecord && mode != cassetteModeReplay {
+		return "", "", fmt.Errorf("connection config has invalid cassette_mode '%s', expected record or replay. Edit your connection configuration file and then restart Steampipe", mode)
+	}
+	if config.CassetteDir == nil || *config.CassetteDir == "" {
+		return "", "", fmt.Errorf("connection config sets cassette_mode but not cassette_dir. Edit your connection configuration file and then restart Steampipe")
+	}
+	return mode, expandPath(*config.CassetteDir), nil
+}
+
+// getCassettePath returns the file of the interaction of a request
+func getCassettePath(dir string, service string, method string, url string, body []byte) string {
+	hash := sha256.New()
+	hash.Write([]byte(method + " " + url + "\n"))
+	hash.Write(normalizeCassetteBody(body))
+	return filepath.Join(dir, service, fmt.Sprintf("%s-%s.json", strings.ToLower(method), hex.EncodeToString(hash.Sum(nil))[:16]))
+}
+
+// normalizeCassetteBody returns a JSON body without its volatile keys, with
+// sorted keys. Other bodies are returned as they are.
+func normalizeCassetteBody(body []byte) []byte {
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var value map[string]interface{}
+	if decoder.Decode(&value) != nil {
+		return body
+	}
+	for _, key := range cassetteVolatileKeys {
+		delete(value, key)
+	}
+	normalized, err := json.Marshal(value)
+	if err != nil {
+		return body
+	}
+	return normalized
+}
+
+// readRequestBody reads the body of a request, and restores it for the dispatcher
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	req.Body.Close()
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
+
+// redactHeader returns a copy of a header without credentials
+func redactHeader(header http.Header) http.Header {
+	redacted := header.Clone()
+	for _, key := range cassetteRedactedHeaders {
+		if redacted.Get(key) != "" {
+			redacted.Set(key, cassetteRedacted)
+		}
+	}
+	return redacted
+}
+
+// redactBody replaces the values of secret keys of a JSON body
+func redactBody(body []byte) []byte {
+	// numbers are kept as they are, e.g. sizes in bytes
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var value interface{}
+	if decoder.Decode(&value) != nil {
+		return body
+	}
+	redacted, err := json.Marshal(redactValue(value))
+	if err != nil {
+		return body
+	}
+	return redacted
+}
+
+func redactValue(value interface{}) interface{} {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		for key, item := range value {
+			if isSecretKey(key) {
+				value[key] = cassetteRedacted
+			} else {
+				value[key] = redactValue(item)
+			}
+		}
+	case []interface{}:
+		for i, item := range value {
+			value[i] = redactValue(item)
+		}
+	}
+	return value
+}
+
+func isSecretKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, secret := range cassetteRedactedKeys {
+		if key == secret || (secret != "token" && strings.Contains(key, secret)) {
+			return true
+		}
+	}
+	return false
+}
+
+// cassetteRecorder writes every request sent by a service client, and its response, to the cassette directory
+type cassetteRecorder struct {
+	dispatcher oci_common.HTTPRequestDispatcher
+	dir        string
+	service    string
+}
+
+func (r cassetteRecorder) Do(req *http.Request) (*http.Response, error) {
+	requestBody, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.dispatcher.Do(req)
+	if err != nil {
+		return resp, err
+	}
+
+	responseBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(responseBody))
+	if err != nil {
+		return resp, err
+	}
+
+	interaction := cassetteInteraction{
+		Request: cassetteRequest{
+			Method: req.Method,
+			URL:    req.URL.String(),
+			Header: redactHeader(req.Header),
+			Body:   string(redactBody(requestBody)),
+		},
+		Response: cassetteResponse{
+			StatusCode: resp.StatusCode,
+			Header:     redactHeader(resp.Header),
+			Body:       string(redactBody(responseBody)),
+		},
+	}
+	path := getCassettePath(r.dir, r.service, req.Method, req.URL.String(), requestBody)
+	if err := writeCassetteInteraction(path, interaction); err != nil {
+		return nil, fmt.Errorf("failed to record %s %s: %v", req.Method, req.URL.String(), err)
+	}
+	return resp, nil
+}
+
+// writeCassetteInteraction writes an interaction through a temporary file, so concurrent replays never read a partial file
+func writeCassetteInteraction(path string, interaction cassetteInteraction) error {
+	data, err := json.MarshalIndent(interaction, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	file, err := os.CreateTemp(filepath.Dir(path), ".interaction-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	return os.Rename(file.Name(), path)
+}
+
+// cassetteReplayer serves the recorded responses of the cassette directory instead of sending requests
+type cassetteReplayer struct {
+	dir     string
+	service string
+}
+
+func (r cassetteReplayer) Do(req *http.Request) (*http.Response, error) {
+	requestBody, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	path := getCassettePath(r.dir, r.service, req.Method, req.URL.String(), requestBody)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("no recorded response for %s %s in cassette %s", req.Method, req.URL.String(), r.dir)
+	}
+	var interaction cassetteInteraction
+	if err := json.Unmarshal(data, &interaction); err != nil {
+		return nil, fmt.Errorf("invalid cassette interaction %s: %v", path, err)
+	}
+
+	header := interaction.Response.Header
+	if header == nil {
+		header = http.Header{}
+	}
+	// redacted bodies no longer have the recorded length
+	header.Del("Content-Length")
+
+	return &http.Response{
+		StatusCode:    interaction.Response.StatusCode,
+		Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(interaction.Response.Body)),
+		ContentLength: int64(len(interaction.Response.Body)),
+		Request:       req,
+	}, nil
+}
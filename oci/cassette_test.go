@@ -0,0 +1,66 @@
+package oci
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestGetCassettePath(t *testing.T) {
+	path := func(body string) string {
+		return getCassettePath("cassettes", "monitoring", "POST", "https://telemetry.us-ashburn-1.oraclecloud.com/20180401/metrics/actions/summarizeMetricsData", []byte(body))
+	}
+	recorded := path(`{"namespace":"oci_computeagent","query":"CpuUtilization[1m].mean()","startTime":"2022-06-01T10:00:00.123Z","endTime":"2022-06-02T10:00:00.123Z"}`)
+
+	tests := []struct {
+		name string
+		body string
+		same bool
+	}{
+		{"later time range", `{"namespace":"oci_computeagent","query":"CpuUtilization[1m].mean()","startTime":"2022-06-01T11:00:00.456Z","endTime":"2022-06-02T11:00:00.456Z"}`, true},
+		{"without time range", `{"namespace":"oci_computeagent","query":"CpuUtilization[1m].mean()"}`, true},
+		{"other key order", `{"query":"CpuUtilization[1m].mean()","endTime":"2022-06-02T10:00:00.123Z","namespace":"oci_computeagent"}`, true},
+		{"other query", `{"namespace":"oci_computeagent","query":"CpuUtilization[1m].max()","startTime":"2022-06-01T10:00:00.123Z","endTime":"2022-06-02T10:00:00.123Z"}`, false},
+		{"no body", ``, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if same := path(test.body) == recorded; same != test.same {
+				t.Errorf("getCassettePath() of %s is the recorded path: %t, want %t", test.body, same, test.same)
+			}
+		})
+	}
+}
+
+func TestCassetteRecordReplayMetrics(t *testing.T) {
+	server := newTestServer(t, "testdata/monitoring_metric")
+	dir := t.TempDir()
+	columns := []string{"timestamp", "value", "end_time"}
+	quals := map[string]string{"namespace": "oci_computeagent", "query": "CpuUtilization[1m].mean()"}
+
+	recorded, err := queryConnection(t, "oci_record", server.Config()+fmt.Sprintf("cassette_mode = \"record\"\ncassette_dir  = %q\n", dir), "oci_monitoring_metric", columns, quals)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(recorded) != 2 {
+		t.Fatalf("recorded %d rows, want 2", len(recorded))
+	}
+
+	// the replay runs offline, and later: its time range ends at a later time than the recorded one
+	server.Close()
+	replayed, err := queryConnection(t, "oci_replay", server.Config()+fmt.Sprintf("cassette_mode = \"replay\"\ncassette_dir  = %q\n", dir), "oci_monitoring_metric", columns, quals)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(replayed) != len(recorded) {
+		t.Fatalf("replayed %d rows, want %d", len(replayed), len(recorded))
+	}
+	for i := range recorded {
+		if !replayed[i]["end_time"].GetTimestampValue().AsTime().After(recorded[i]["end_time"].GetTimestampValue().AsTime()) {
+			t.Errorf("replay end_time %s is not after the recorded end_time %s", replayed[i]["end_time"].GetTimestampValue().AsTime(), recorded[i]["end_time"].GetTimestampValue().AsTime())
+		}
+		if replayed[i]["value"].GetDoubleValue() != recorded[i]["value"].GetDoubleValue() || !replayed[i]["timestamp"].GetTimestampValue().AsTime().Equal(recorded[i]["timestamp"].GetTimestampValue().AsTime()) {
+			t.Errorf("replayed row %v, want %v", replayed[i], recorded[i])
+		}
+	}
+}
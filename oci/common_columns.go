@@ -1,5 +1,16 @@
 package oci
 
+import (
+	"context"
+	"reflect"
+	"strings"
+
+	oci_common "github.com/oracle/oci-go-sdk/v44/common"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
 const (
 	// Constants for Standard Column Descriptions
 	ColumnDescriptionAkas        = "Array of globally unique identifier strings (also known as) for the resource."
@@ -14,3 +25,210 @@ const (
 	ColumnDescriptionDefinedTags  = "Defined tags for resource. Defined tags are set up in your tenancy by an administrator. Only users granted permission to work with the defined tags can apply them to resources."
 	ColumnDescriptionSystemTags   = "System tags for resource. System tags can be viewed by users, but can only be created by the system."
 )
+
+/*
+CommonColumns appends the standard columns which a table does not define
+itself, and the compartment hierarchy columns, onto the column list:
+
+  - tags: free-form tags, plus defined and system tags flattened as namespace.key
+  - title: the display name, name or OCID of the resource
+  - akas: the OCID of the resource
+  - region: the region of the OCID, or of the matrix item
+  - compartment_id: the compartment of the resource, or of the matrix item
+  - tenant_id: the tenancy of the connection
+
+Tables define a standard column themselves when the resource needs a
+different value, e.g. a title from another field.
+*/
+func CommonColumns(columns []*plugin.Column) []*plugin.Column {
+	return CompartmentColumns(appendCommonColumns(columns, commonColumns()))
+}
+
+// TenancyColumns appends the standard columns onto the column list of
+// resources which are not located in a compartment, e.g. availability
+// domains. These tables have no compartment_id, compartment_name and
+// compartment_path columns, unless they define them themselves.
+func TenancyColumns(columns []*plugin.Column) []*plugin.Column {
+	tenancyColumns := []*plugin.Column{}
+	for _, column := range commonColumns() {
+		if column.Name != "compartment_id" {
+			tenancyColumns = append(tenancyColumns, column)
+		}
+	}
+	return appendCommonColumns(columns, tenancyColumns)
+}
+
+// appendCommonColumns appends the standard columns a table does not define itself
+func appendCommonColumns(columns []*plugin.Column, commonColumns []*plugin.Column) []*plugin.Column {
+	defined := map[string]bool{}
+	for _, column := range columns {
+		defined[column.Name] = true
+	}
+	for _, column := range commonColumns {
+		if !defined[column.Name] {
+			columns = append(columns, column)
+		}
+	}
+	return columns
+}
+
+func commonColumns() []*plugin.Column {
+	return []*plugin.Column{
+		{
+			Name:        "tags",
+			Description: ColumnDescriptionTags,
+			Type:        proto.ColumnType_JSON,
+			Transform:   transform.From(commonTags),
+		},
+		{
+			Name:        "title",
+			Description: ColumnDescriptionTitle,
+			Type:        proto.ColumnType_STRING,
+			Transform:   transform.FromField("DisplayName", "Name", "Id"),
+		},
+		{
+			Name:        "akas",
+			Description: ColumnDescriptionAkas,
+			Type:        proto.ColumnType_JSON,
+			Transform:   transform.From(commonAkas),
+		},
+		{
+			Name:        "region",
+			Description: ColumnDescriptionRegion,
+			Type:        proto.ColumnType_STRING,
+			Transform:   transform.From(commonRegion),
+		},
+		{
+			Name:        "compartment_id",
+			Description: ColumnDescriptionCompartment,
+			Type:        proto.ColumnType_STRING,
+			Transform:   transform.From(commonCompartmentId),
+		},
+		{
+			Name:        "tenant_id",
+			Description: ColumnDescriptionTenant,
+			Type:        proto.ColumnType_STRING,
+			Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(tenancyCacheKey("getTenantId")),
+			Transform:   transform.FromValue(),
+		},
+	}
+}
+
+//// TRANSFORM FUNCTIONS
+
+// commonTags merges the free-form, defined and system tags of a resource.
+// Defined and system tags are keyed namespace.key, e.g. Operations.CostCenter,
+// so tags of different namespaces never overwrite each other.
+func commonTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	var tags map[string]interface{}
+
+	if freeformTags, ok := getItemField(d.HydrateItem, "FreeformTags"); ok {
+		if freeformTags, ok := freeformTags.Interface().(map[string]string); ok && freeformTags != nil {
+			tags = map[string]interface{}{}
+			for key, value := range freeformTags {
+				tags[key] = value
+			}
+		}
+	}
+
+	for _, name := range []string{"DefinedTags", "SystemTags"} {
+		namespaces, ok := getItemField(d.HydrateItem, name)
+		if !ok {
+			continue
+		}
+		if namespaces, ok := namespaces.Interface().(map[string]map[string]interface{}); ok && namespaces != nil {
+			if tags == nil {
+				tags = map[string]interface{}{}
+			}
+			for namespace, namespaceTags := range namespaces {
+				for key, value := range namespaceTags {
+					tags[namespace+"."+key] = value
+				}
+			}
+		}
+	}
+
+	return tags, nil
+}
+
+func commonAkas(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	id, ok := getItemStringField(d.HydrateItem, "Id")
+	if !ok || id == "" {
+		return nil, nil
+	}
+	return []string{id}, nil
+}
+
+// commonRegion returns the region of the OCID of a resource, e.g.
+// ocid1.instance.oc1.iad.<unique id>. Resources without a regional OCID take
+// the region of the matrix item.
+func commonRegion(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	if id, ok := getItemStringField(d.HydrateItem, "Id"); ok {
+		if splittedID := strings.Split(id, "."); len(splittedID) > 4 && splittedID[3] != "" {
+			return oci_common.StringToRegion(splittedID[3]), nil
+		}
+	}
+	if region, ok := d.MatrixItem[matrixKeyRegion].(string); ok {
+		return region, nil
+	}
+	return nil, nil
+}
+
+// commonCompartmentId returns the compartment of a resource. Resources
+// without a CompartmentId field take the compartment of the matrix item.
+func commonCompartmentId(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	compartmentId, ok := getItemStringField(d.HydrateItem, "CompartmentId")
+	if !ok {
+		compartmentId, _ = d.MatrixItem[matrixKeyCompartment].(string)
+	}
+	if compartmentId == "" {
+		return nil, nil
+	}
+	return compartmentId, nil
+}
+
+//// HELPER FUNCTIONS
+
+// getItemField returns a field of a row item, and whether the item has one
+func getItemField(item interface{}, name string) (reflect.Value, bool) {
+	value := reflect.ValueOf(item)
+	for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return reflect.Value{}, false
+		}
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return reflect.Value{}, false
+	}
+
+	field, ok := value.Type().FieldByName(name)
+	if !ok {
+		return reflect.Value{}, false
+	}
+	// promoted fields of nil embedded structs cannot be read
+	fieldValue, err := value.FieldByIndexErr(field.Index)
+	if err != nil {
+		return reflect.Value{}, false
+	}
+	return fieldValue, true
+}
+
+// getItemStringField returns a string field of a row item, and whether the
+// item has one. Nil fields are empty strings.
+func getItemStringField(item interface{}, name string) (string, bool) {
+	field, ok := getItemField(item, name)
+	if !ok {
+		return "", false
+	}
+	if field.Kind() == reflect.Ptr {
+		if field.IsNil() {
+			return "", true
+		}
+		field = field.Elem()
+	}
+	if field.Kind() != reflect.String {
+		return "", false
+	}
+	return field.String(), true
+}
@@ -0,0 +1,53 @@
+package oci
+
+import (
+	"testing"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+)
+
+func columnNames(columns []*plugin.Column) map[string]int {
+	names := map[string]int{}
+	for _, column := range columns {
+		names[column.Name]++
+	}
+	return names
+}
+
+func TestCommonColumns(t *testing.T) {
+	title := &plugin.Column{Name: "title", Type: proto.ColumnType_STRING}
+	columns := CommonColumns([]*plugin.Column{{Name: "id", Type: proto.ColumnType_STRING}, title})
+
+	names := columnNames(columns)
+	for _, name := range []string{"id", "tags", "title", "akas", "region", "compartment_id", "compartment_name", "compartment_path", "tenant_id"} {
+		if names[name] != 1 {
+			t.Errorf("CommonColumns() has %d %s columns, want 1", names[name], name)
+		}
+	}
+	for _, column := range columns {
+		if column.Name == "title" && column != title {
+			t.Error("CommonColumns() replaced the title column of the table")
+		}
+	}
+}
+
+func TestTenancyColumns(t *testing.T) {
+	names := columnNames(TenancyColumns([]*plugin.Column{{Name: "id", Type: proto.ColumnType_STRING}}))
+	for _, name := range []string{"id", "tags", "title", "akas", "region", "tenant_id"} {
+		if names[name] != 1 {
+			t.Errorf("TenancyColumns() has %d %s columns, want 1", names[name], name)
+		}
+	}
+	for _, name := range []string{"compartment_id", "compartment_name", "compartment_path"} {
+		if names[name] != 0 {
+			t.Errorf("TenancyColumns() has a %s column", name)
+		}
+	}
+
+	// tables which define a compartment column keep it
+	names = columnNames(TenancyColumns([]*plugin.Column{{Name: "compartment_id", Type: proto.ColumnType_STRING}}))
+	if names["compartment_id"] != 1 {
+		t.Errorf("TenancyColumns() has %d compartment_id columns, want 1", names["compartment_id"])
+	}
+}
@@ -0,0 +1,108 @@
+package oci
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+// name and path of the root compartment of a tenancy
+const rootCompartmentName = "root"
+
+// append the compartment hierarchy columns onto the column list
+func CompartmentColumns(columns []*plugin.Column) []*plugin.Column {
+	return append(columns, commonCompartmentColumns()...)
+}
+
+func commonCompartmentColumns() []*plugin.Column {
+	return []*plugin.Column{
+		{
+			Name:        "compartment_name",
+			Description: "The name of the compartment in Tenant in which the resource is located.",
+			Type:        proto.ColumnType_STRING,
+			Hydrate:     getCompartmentHierarchy,
+			Transform:   transform.FromField("Name"),
+		},
+		{
+			Name:        "compartment_path",
+			Description: "The path of the compartment in Tenant in which the resource is located, starting at the root compartment, e.g. root/prod/network.",
+			Type:        proto.ColumnType_STRING,
+			Hydrate:     getCompartmentHierarchy,
+			Transform:   transform.FromField("Path"),
+		},
+	}
+}
+
+type compartmentHierarchy struct {
+	Name string
+	Path string
+}
+
+// getCompartmentHierarchies returns the name and path of every compartment of the tenancy of the current call
+func getCompartmentHierarchies(ctx context.Context, d *plugin.QueryData) (map[string]compartmentHierarchy, error) {
+	cacheKey := fmt.Sprintf("getCompartmentHierarchies-%s", getTenancyName(ctx))
+	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+		return cachedData.(map[string]compartmentHierarchy), nil
+	}
+
+	compartments, err := listAllCompartments(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	paths := getCompartmentPaths(compartments)
+	hierarchies := map[string]compartmentHierarchy{}
+	for _, compartment := range compartments {
+		// the root compartment is the only one without a parent
+		if compartment.CompartmentId == nil {
+			hierarchies[*compartment.Id] = compartmentHierarchy{Name: rootCompartmentName, Path: rootCompartmentName}
+			continue
+		}
+		if compartment.Name == nil || paths[*compartment.Id] == "" {
+			continue
+		}
+		hierarchies[*compartment.Id] = compartmentHierarchy{
+			Name: *compartment.Name,
+			Path: rootCompartmentName + "/" + paths[*compartment.Id],
+		}
+	}
+
+	d.ConnectionManager.Cache.Set(cacheKey, hierarchies)
+	return hierarchies, nil
+}
+
+// getCompartmentHierarchy returns the name and path of the compartment of a
+// row. The compartment is read from the CompartmentId field of the row, or
+// the matrix item of rows without one. Rows with an empty CompartmentId, e.g.
+// platform images, belong to the root compartment.
+func getCompartmentHierarchy(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	compartmentId, ok := getItemStringField(h.Item, "CompartmentId")
+	if !ok {
+		if compartment, ok := plugin.GetMatrixItem(ctx)[matrixKeyCompartment].(string); ok {
+			compartmentId = compartment
+		}
+	}
+
+	hierarchies, err := getCompartmentHierarchies(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// rows without a compartment belong to the tenancy
+	if compartmentId == "" {
+		for _, hierarchy := range hierarchies {
+			if hierarchy.Path == rootCompartmentName {
+				return hierarchy, nil
+			}
+		}
+		return nil, nil
+	}
+
+	if hierarchy, ok := hierarchies[compartmentId]; ok {
+		return hierarchy, nil
+	}
+	return nil, nil
+}
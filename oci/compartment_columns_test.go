@@ -0,0 +1,65 @@
+package oci
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/turbot/steampipe-plugin-oci/oci/ocitest"
+)
+
+func TestGetCompartmentHierarchies(t *testing.T) {
+	page := func(compartments ...map[string]string) json.RawMessage {
+		data, err := json.Marshal(compartments)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return data
+	}
+	compartment := func(id string, name string, parent string, state string) map[string]string {
+		return map[string]string{"id": id, "name": name, "compartmentId": parent, "lifecycleState": state}
+	}
+	server, err := ocitest.NewServer([]ocitest.Fixture{{
+		Service: "identity",
+		Path:    "/20160918/compartments",
+		Query:   map[string]string{"compartmentId": ocitest.TenancyOCID, "compartmentIdInSubtree": "true"},
+		Pages: []json.RawMessage{
+			page(
+				compartment("ocid1.compartment.oc1..network", "network", "ocid1.compartment.oc1..prod", "ACTIVE"),
+				compartment("ocid1.compartment.oc1..prod", "prod", ocitest.TenancyOCID, "ACTIVE"),
+			),
+			page(
+				compartment("ocid1.compartment.oc1..old", "old", ocitest.TenancyOCID, "DELETED"),
+				compartment("ocid1.compartment.oc1..dev", "dev", ocitest.TenancyOCID, "ACTIVE"),
+			),
+		},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer server.Close()
+
+	d := newTestQueryData(t, server.Config())
+	hierarchies, err := getCompartmentHierarchies(newTestContext(), d)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]compartmentHierarchy{
+		ocitest.TenancyOCID:              {Name: "root", Path: "root"},
+		"ocid1.compartment.oc1..prod":    {Name: "prod", Path: "root/prod"},
+		"ocid1.compartment.oc1..network": {Name: "network", Path: "root/prod/network"},
+		"ocid1.compartment.oc1..dev":     {Name: "dev", Path: "root/dev"},
+		// deleted compartments are not listed
+	}
+	if len(hierarchies) != len(want) {
+		t.Errorf("getCompartmentHierarchies() = %v, want %v", hierarchies, want)
+	}
+	for id, hierarchy := range want {
+		if hierarchies[id] != hierarchy {
+			t.Errorf("hierarchy of %s = %v, want %v", id, hierarchies[id], hierarchy)
+		}
+	}
+	if unmatched := server.Unmatched(); len(unmatched) > 0 {
+		t.Errorf("unmatched requests %v", unmatched)
+	}
+}
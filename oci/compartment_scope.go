@@ -0,0 +1,155 @@
+package oci
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/oracle/oci-go-sdk/v44/identity"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+)
+
+// getCompartmentPaths returns the path of every compartment relative to the
+// root compartment of the tenancy, e.g. "prod/network". The root compartment
+// has an empty path.
+func getCompartmentPaths(compartments []identity.Compartment) map[string]string {
+	byId := map[string]identity.Compartment{}
+	for _, compartment := range compartments {
+		byId[*compartment.Id] = compartment
+	}
+
+	paths := map[string]string{}
+	var resolve func(id string, depth int) string
+	resolve = func(id string, depth int) string {
+		if p, ok := paths[id]; ok {
+			return p
+		}
+		compartment, ok := byId[id]
+		// the root compartment has no name and no parent in the compartment list
+		if !ok || compartment.Name == nil || compartment.CompartmentId == nil || depth > len(compartments) {
+			return ""
+		}
+		p := *compartment.Name
+		if parent := resolve(*compartment.CompartmentId, depth+1); parent != "" {
+			p = parent + "/" + p
+		}
+		paths[id] = p
+		return p
+	}
+
+	for _, compartment := range compartments {
+		paths[*compartment.Id] = resolve(*compartment.Id, 0)
+	}
+	return paths
+}
+
+// matchCompartmentPath matches a compartment path against a glob pattern.
+// A "*" matches a single path element and "**" matches any number of path
+// elements, including none, so "prod/**" matches "prod" and all its descendants.
+// Patterns may also start with the root compartment, like the values of the
+// compartment_path column, e.g. "root/prod/**".
+func matchCompartmentPath(pattern string, compartmentPath string) bool {
+	if matchPathElements(strings.Split(pattern, "/"), strings.Split(compartmentPath, "/")) {
+		return true
+	}
+	if strings.HasPrefix(pattern, rootCompartmentName+"/") {
+		return matchPathElements(strings.Split(pattern, "/"), strings.Split(rootCompartmentName+"/"+compartmentPath, "/"))
+	}
+	return false
+}
+
+func matchPathElements(pattern []string, elements []string) bool {
+	if len(pattern) == 0 {
+		return len(elements) == 0
+	}
+	if pattern[0] == "**" {
+		for i := 0; i <= len(elements); i++ {
+			if matchPathElements(pattern[1:], elements[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+	if len(elements) == 0 {
+		return false
+	}
+	if ok, _ := path.Match(pattern[0], elements[0]); !ok {
+		return false
+	}
+	return matchPathElements(pattern[1:], elements[1:])
+}
+
+// compartmentMatches returns true if the compartment or one of its ancestors
+// is selected by the filter. Filters are compartment OCIDs, names or path globs.
+func compartmentMatches(filter string, compartmentPath string, ancestors []identity.Compartment) bool {
+	// path globs select exactly the compartments they match
+	if strings.Contains(filter, "/") || strings.ContainsAny(filter, "*?[") {
+		return matchCompartmentPath(filter, compartmentPath)
+	}
+
+	// OCIDs and names select the compartment and its subtree
+	for _, compartment := range ancestors {
+		if strings.HasPrefix(filter, "ocid1.") {
+			if *compartment.Id == filter {
+				return true
+			}
+		} else if compartment.Name != nil && *compartment.Name == filter {
+			return true
+		}
+	}
+	return false
+}
+
+// filterCompartments applies the `include_compartments` and
+// `exclude_compartments` connection settings to the compartments of a tenancy.
+// The root compartment is always kept, as it is used to get resources by id.
+func filterCompartments(connection *plugin.Connection, compartments []identity.Compartment) ([]identity.Compartment, error) {
+	config := GetConfig(connection)
+	if len(config.IncludeCompartments) == 0 && len(config.ExcludeCompartments) == 0 {
+		return compartments, nil
+	}
+
+	for _, filter := range append(append([]string{}, config.IncludeCompartments...), config.ExcludeCompartments...) {
+		if _, err := path.Match(strings.ReplaceAll(filter, "**", "*"), ""); err != nil {
+			return nil, fmt.Errorf("connection config has invalid compartment filter '%s'. Edit your connection configuration file and then restart Steampipe", filter)
+		}
+	}
+
+	byId := map[string]identity.Compartment{}
+	for _, compartment := range compartments {
+		byId[*compartment.Id] = compartment
+	}
+	paths := getCompartmentPaths(compartments)
+
+	filtered := []identity.Compartment{}
+	for _, compartment := range compartments {
+		// the root compartment has no parent in the compartment list
+		if compartment.CompartmentId == nil {
+			filtered = append(filtered, compartment)
+			continue
+		}
+
+		// collect the compartment and all its ancestors below the root
+		ancestors := []identity.Compartment{}
+		for current, ok := compartment, true; ok && len(ancestors) <= len(compartments); current, ok = byId[*current.CompartmentId] {
+			ancestors = append(ancestors, current)
+			if current.CompartmentId == nil {
+				break
+			}
+		}
+
+		include := len(config.IncludeCompartments) == 0
+		for _, filter := range config.IncludeCompartments {
+			include = include || compartmentMatches(filter, paths[*compartment.Id], ancestors)
+		}
+		for _, filter := range config.ExcludeCompartments {
+			include = include && !compartmentMatches(filter, paths[*compartment.Id], ancestors)
+		}
+
+		if include {
+			filtered = append(filtered, compartment)
+		}
+	}
+
+	return filtered, nil
+}
@@ -0,0 +1,159 @@
+package oci
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/oracle/oci-go-sdk/v44/identity"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+)
+
+const testRootCompartment = "ocid1.tenancy.oc1..aaaaaaaafake"
+
+// testCompartments returns a compartment tree, with the root compartment as
+// added by listAllCompartments:
+//
+//	prod, prod/network, prod/app, prod/app/archive, sandbox, dev, dev/sandbox
+func testCompartments() []identity.Compartment {
+	compartment := func(id string, name string, parent string) identity.Compartment {
+		return identity.Compartment{Id: types.String("ocid1.compartment.oc1.." + id), Name: types.String(name), CompartmentId: types.String(parent)}
+	}
+	id := func(id string) string { return "ocid1.compartment.oc1.." + id }
+	return []identity.Compartment{
+		{Id: types.String(testRootCompartment)},
+		// children are listed before their parents, so paths are resolved recursively
+		compartment("archive", "archive", id("app")),
+		compartment("prod", "prod", testRootCompartment),
+		compartment("network", "network", id("prod")),
+		compartment("app", "app", id("prod")),
+		compartment("sandbox", "sandbox", testRootCompartment),
+		compartment("dev", "dev", testRootCompartment),
+		compartment("devsandbox", "sandbox", id("dev")),
+	}
+}
+
+func TestGetCompartmentPaths(t *testing.T) {
+	want := map[string]string{
+		testRootCompartment:                 "",
+		"ocid1.compartment.oc1..prod":       "prod",
+		"ocid1.compartment.oc1..network":    "prod/network",
+		"ocid1.compartment.oc1..app":        "prod/app",
+		"ocid1.compartment.oc1..archive":    "prod/app/archive",
+		"ocid1.compartment.oc1..sandbox":    "sandbox",
+		"ocid1.compartment.oc1..dev":        "dev",
+		"ocid1.compartment.oc1..devsandbox": "dev/sandbox",
+	}
+	paths := getCompartmentPaths(testCompartments())
+	if len(paths) != len(want) {
+		t.Errorf("getCompartmentPaths() = %v, want %v", paths, want)
+	}
+	for id, path := range want {
+		if paths[id] != path {
+			t.Errorf("path of %s = %q, want %q", id, paths[id], path)
+		}
+	}
+
+	// compartments with an unknown parent, e.g. a parent which is being created, are relative to it
+	orphan := []identity.Compartment{{Id: types.String("ocid1.compartment.oc1..orphan"), Name: types.String("orphan"), CompartmentId: types.String("ocid1.compartment.oc1..unknown")}}
+	if path := getCompartmentPaths(orphan)["ocid1.compartment.oc1..orphan"]; path != "orphan" {
+		t.Errorf("path of an orphan compartment = %q, want orphan", path)
+	}
+}
+
+func TestMatchCompartmentPath(t *testing.T) {
+	tests := []struct {
+		pattern string
+		path    string
+		want    bool
+	}{
+		{"prod", "prod", true},
+		{"prod", "prod/network", false},
+		{"prod/network", "prod/network", true},
+		{"prod/*", "prod/network", true},
+		{"prod/*", "prod", false},
+		{"prod/*", "prod/app/archive", false},
+		{"prod/**", "prod", true},
+		{"prod/**", "prod/app/archive", true},
+		{"prod/**", "production", false},
+		{"prod*", "production", true},
+		{"**/archive", "prod/app/archive", true},
+		{"**/archive", "archive", true},
+		{"prod/*/archive", "prod/app/archive", true},
+		{"prod/*/archive", "prod/archive", false},
+		{"*/sandbox", "dev/sandbox", true},
+		{"*/sandbox", "sandbox", false},
+		// paths of the compartment_path column start with the root compartment
+		{"root/prod/**", "prod/app", true},
+		{"root/prod", "prod", true},
+		{"root/*", "sandbox", true},
+		{"root/*", "dev/sandbox", false},
+		{"root/prod", "root/prod", true},
+	}
+
+	for _, test := range tests {
+		if got := matchCompartmentPath(test.pattern, test.path); got != test.want {
+			t.Errorf("matchCompartmentPath(%q, %q) = %t, want %t", test.pattern, test.path, got, test.want)
+		}
+	}
+}
+
+func TestFilterCompartments(t *testing.T) {
+	tests := []struct {
+		name    string
+		include []string
+		exclude []string
+		want    []string
+	}{
+		{"no filters", nil, nil, []string{"app", "archive", "dev", "devsandbox", "network", "prod", "sandbox"}},
+		{"OCID selects the subtree", []string{"ocid1.compartment.oc1..app"}, nil, []string{"app", "archive"}},
+		{"name selects every subtree with the name", []string{"sandbox"}, nil, []string{"devsandbox", "sandbox"}},
+		{"glob selects exactly the matches", []string{"prod/*"}, nil, []string{"app", "network"}},
+		{"** selects the compartment and its descendants", []string{"prod/**"}, nil, []string{"app", "archive", "network", "prod"}},
+		{"compartment_path values", []string{"root/prod/**"}, nil, []string{"app", "archive", "network", "prod"}},
+		{"exclude by name", nil, []string{"sandbox"}, []string{"app", "archive", "dev", "network", "prod"}},
+		{"exclude a subtree by OCID", nil, []string{"ocid1.compartment.oc1..prod"}, []string{"dev", "devsandbox", "sandbox"}},
+		{"include and exclude", []string{"prod/**"}, []string{"prod/*/archive"}, []string{"app", "network", "prod"}},
+		{"no match", []string{"staging/**"}, nil, []string{}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			connection := &plugin.Connection{Name: "oci", Config: ociConfig{IncludeCompartments: test.include, ExcludeCompartments: test.exclude}}
+			filtered, err := filterCompartments(connection, testCompartments())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// the root compartment is always kept
+			got := []string{}
+			root := false
+			for _, compartment := range filtered {
+				if *compartment.Id == testRootCompartment {
+					root = true
+					continue
+				}
+				got = append(got, (*compartment.Id)[len("ocid1.compartment.oc1.."):])
+			}
+			sort.Strings(got)
+			if !root {
+				t.Error("the root compartment was filtered")
+			}
+			if len(got) != len(test.want) {
+				t.Fatalf("filtered compartments %v, want %v", got, test.want)
+			}
+			for i := range got {
+				if got[i] != test.want[i] {
+					t.Fatalf("filtered compartments %v, want %v", got, test.want)
+				}
+			}
+		})
+	}
+}
+
+func TestFilterCompartmentsInvalid(t *testing.T) {
+	connection := &plugin.Connection{Name: "oci", Config: ociConfig{IncludeCompartments: []string{"prod/[a"}}}
+	if _, err := filterCompartments(connection, testCompartments()); err == nil {
+		t.Error("an invalid glob was accepted")
+	}
+}
@@ -7,14 +7,33 @@ import (
 
 type ociConfig struct {
 	Auth                  *string  `cty:"auth"`
+	CaBundlePath          *string  `cty:"ca_bundle_path"`
+	CassetteDir           *string  `cty:"cassette_dir"`
+	CassetteMode          *string  `cty:"cassette_mode"`
+	ClientCertificatePath *string  `cty:"client_certificate_path"`
+	ClientKeyPath         *string  `cty:"client_key_path"`
 	ConfigPath            *string  `cty:"config_path"`
+	ConnectTimeout        *int     `cty:"connect_timeout"`
+	DiskCache             *bool    `cty:"disk_cache"`
+	DiskCacheTTLs         []string `cty:"disk_cache_ttls"`
+	Endpoints             []string `cty:"endpoints"`
+	ExcludeCompartments   []string `cty:"exclude_compartments"`
 	Fingerprint           *string  `cty:"fingerprint"`
+	IgnoreErrorCodes      []string `cty:"ignore_error_codes"`
+	IncludeCompartments   []string `cty:"include_compartments"`
+	InsecureSkipVerify    *bool    `cty:"insecure_skip_verify"`
 	PrivateKey            *string  `cty:"private_key"`
 	PrivateKeyPassword    *string  `cty:"private_key_password"`
 	PrivateKeyPath        *string  `cty:"private_key_path"`
 	Profile               *string  `cty:"config_file_profile"`
+	ProxyUrl              *string  `cty:"proxy_url"`
+	RateLimits            []string `cty:"rate_limits"`
+	RealmDomain           *string  `cty:"realm_domain"`
 	Regions               []string `cty:"regions"`
+	RequestTimeout        *int     `cty:"request_timeout"`
+	Tenancies             []string `cty:"tenancies"`
 	TenancyOCID           *string  `cty:"tenancy_ocid"`
+	TLSHandshakeTimeout   *int     `cty:"tls_handshake_timeout"`
 	UserOCID              *string  `cty:"user_ocid"`
 	MaxErrorRetryAttempts *int     `cty:"max_error_retry_attempts"`
 	MinErrorRetryDelay    *int     `cty:"min_error_retry_delay"`
@@ -25,6 +44,29 @@ var ConfigSchema = map[string]*schema.Attribute{
 		Type: schema.TypeList,
 		Elem: &schema.Attribute{Type: schema.TypeString},
 	},
+	"tenancies": {
+		Type: schema.TypeList,
+		Elem: &schema.Attribute{Type: schema.TypeString},
+	},
+	"include_compartments": {
+		Type: schema.TypeList,
+		Elem: &schema.Attribute{Type: schema.TypeString},
+	},
+	"exclude_compartments": {
+		Type: schema.TypeList,
+		Elem: &schema.Attribute{Type: schema.TypeString},
+	},
+	"ignore_error_codes": {
+		Type: schema.TypeList,
+		Elem: &schema.Attribute{Type: schema.TypeString},
+	},
+	"endpoints": {
+		Type: schema.TypeList,
+		Elem: &schema.Attribute{Type: schema.TypeString},
+	},
+	"realm_domain": {
+		Type: schema.TypeString,
+	},
 	"auth": {
 		Type: schema.TypeString,
 	},
@@ -52,12 +94,53 @@ var ConfigSchema = map[string]*schema.Attribute{
 	"private_key_password": {
 		Type: schema.TypeString,
 	},
+	"proxy_url": {
+		Type: schema.TypeString,
+	},
+	"ca_bundle_path": {
+		Type: schema.TypeString,
+	},
+	"insecure_skip_verify": {
+		Type: schema.TypeBool,
+	},
+	"client_certificate_path": {
+		Type: schema.TypeString,
+	},
+	"client_key_path": {
+		Type: schema.TypeString,
+	},
+	"connect_timeout": {
+		Type: schema.TypeInt,
+	},
+	"tls_handshake_timeout": {
+		Type: schema.TypeInt,
+	},
+	"request_timeout": {
+		Type: schema.TypeInt,
+	},
+	"rate_limits": {
+		Type: schema.TypeList,
+		Elem: &schema.Attribute{Type: schema.TypeString},
+	},
 	"max_error_retry_attempts": {
 		Type: schema.TypeInt,
 	},
 	"min_error_retry_delay": {
 		Type: schema.TypeInt,
 	},
+	"cassette_mode": {
+		Type: schema.TypeString,
+	},
+	"cassette_dir": {
+		Type: schema.TypeString,
+	},
+	"disk_cache": {
+		Type: schema.TypeBool,
+	},
+	"disk_cache_ttls": {
+		Type: schema.TypeList,
+		Elem: &schema.Attribute{Type: schema.TypeString},
+	},
 }
 
 func ConfigInstance() interface{} {
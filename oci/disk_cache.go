@@ -0,0 +1,209 @@
+package oci
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+)
+
+// kinds of slow changing data which can be cached on disk
+const (
+	diskCacheCompartments            = "compartments"
+	diskCacheZones                   = "zones"
+	diskCacheNamespace               = "namespace"
+	diskCacheCloudGuardConfiguration = "cloud_guard_configuration"
+)
+
+// default time to live of each kind of data
+var defaultDiskCacheTTLs = map[string]time.Duration{
+	diskCacheCompartments:            time.Hour,
+	diskCacheZones:                   24 * time.Hour,
+	diskCacheNamespace:               24 * time.Hour,
+	diskCacheCloudGuardConfiguration: time.Hour,
+}
+
+// diskCacheEntry is the content of a cache file
+type diskCacheEntry struct {
+	// hash of the connection config and credentials the value was read with
+	ConfigHash string          `json:"config_hash"`
+	WrittenAt  time.Time       `json:"written_at"`
+	Value      json.RawMessage `json:"value"`
+}
+
+/*
+The `disk_cache` connection option keeps compartments, availability domains,
+the object storage namespace and the Cloud Guard configuration on disk, so a
+restarted plugin does not list them again. `disk_cache_ttls` changes the time
+to live of a kind of data, and a TTL of 0 disables the cache for it:
+
+	disk_cache      = true
+	disk_cache_ttls = ["compartments=15m", "zones=168h"]
+*/
+func getDiskCacheTTL(config ociConfig, kind string) (time.Duration, error) {
+	ttl := defaultDiskCacheTTLs[kind]
+	for _, entry := range config.DiskCacheTTLs {
+		kv := strings.SplitN(entry, "=", 2)
+		if len(kv) != 2 {
+			return 0, fmt.Errorf("connection config has invalid disk cache TTL '%s', expected kind=duration. Edit your connection configuration file and then restart Steampipe", entry)
+		}
+		if _, ok := defaultDiskCacheTTLs[strings.TrimSpace(kv[0])]; !ok {
+			return 0, fmt.Errorf("connection config has invalid disk cache TTL '%s', the kind must be one of compartments, zones, namespace or cloud_guard_configuration. Edit your connection configuration file and then restart Steampipe", entry)
+		}
+		duration, err := time.ParseDuration(strings.TrimSpace(kv[1]))
+		if err != nil || duration < 0 {
+			return 0, fmt.Errorf("connection config has invalid disk cache TTL '%s', expected kind=duration. Edit your connection configuration file and then restart Steampipe", entry)
+		}
+		if strings.TrimSpace(kv[0]) == kind {
+			ttl = duration
+		}
+	}
+
+	// the TTLs are validated even if the cache is disabled
+	if config.DiskCache == nil || !*config.DiskCache {
+		return 0, nil
+	}
+	return ttl, nil
+}
+
+// getDiskCacheDir returns the cache directory of the plugin, in the Steampipe install dir
+func getDiskCacheDir() string {
+	installDir := os.Getenv("STEAMPIPE_INSTALL_DIR")
+	if installDir == "" {
+		installDir = filepath.Join(getHomeFolder(), ".steampipe")
+	}
+	return filepath.Join(expandPath(installDir), "cache", "oci")
+}
+
+// getDiskCachePath returns the cache file of a kind of data of the connection, tenancy and region
+func getDiskCachePath(connection string, tenancy string, kind string, region string) string {
+	hash := sha256.Sum256([]byte(strings.Join([]string{connection, tenancy, region}, "\n")))
+	return filepath.Join(getDiskCacheDir(), fmt.Sprintf("%s-%s.json", kind, hex.EncodeToString(hash[:])[:16]))
+}
+
+// getDiskCacheConfigHash returns a hash of the connection config of the
+// tenancy and of the OCI config and key files it reads, so cached data is not
+// used once the config or the credentials change
+func getDiskCacheConfigHash(config ociConfig) (string, error) {
+	hash := sha256.New()
+
+	data, err := json.Marshal(config)
+	if err != nil {
+		return "", err
+	}
+	hash.Write(data)
+
+	configPath := types.SafeString(config.ConfigPath)
+	if configPath == "" {
+		configPath = filepath.Join(getHomeFolder(), ".oci", "config")
+	}
+	for _, path := range []string{configPath, types.SafeString(config.PrivateKeyPath)} {
+		if path == "" {
+			continue
+		}
+		// missing files are part of the config as well
+		content, _ := os.ReadFile(expandPath(path))
+		hash.Write([]byte(path + "\n"))
+		hash.Write(content)
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// getDiskCache reads a kind of data of the tenancy of the current call and a
+// region from the disk cache. Unreadable, expired and outdated entries are
+// misses, and so are all entries of a connection with invalid TTLs, whose
+// error is returned once the sessions of the connection are built.
+func getDiskCache[T any](ctx context.Context, d *plugin.QueryData, kind string, region string) (T, bool) {
+	var value T
+
+	config, err := getTenancyConfig(ctx, d)
+	if err != nil {
+		return value, false
+	}
+	ttl, err := getDiskCacheTTL(config, kind)
+	if err != nil || ttl == 0 {
+		return value, false
+	}
+
+	data, err := os.ReadFile(getDiskCachePath(d.Connection.Name, getTenancyName(ctx), kind, region))
+	if err != nil {
+		return value, false
+	}
+	var entry diskCacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return value, false
+	}
+
+	configHash, err := getDiskCacheConfigHash(config)
+	if err != nil || entry.ConfigHash != configHash || time.Since(entry.WrittenAt) > ttl {
+		return value, false
+	}
+	if err := json.Unmarshal(entry.Value, &value); err != nil {
+		return value, false
+	}
+
+	plugin.Logger(ctx).Trace("getDiskCache", "kind", kind, "region", region, "written_at", entry.WrittenAt)
+	return value, true
+}
+
+// setDiskCache writes a kind of data of the tenancy of the current call and a
+// region to the disk cache. Failures are logged, as the data is still cached in memory.
+func setDiskCache[T any](ctx context.Context, d *plugin.QueryData, kind string, region string, value T) {
+	logger := plugin.Logger(ctx)
+
+	config, err := getTenancyConfig(ctx, d)
+	if err != nil {
+		return
+	}
+	ttl, err := getDiskCacheTTL(config, kind)
+	if err != nil || ttl == 0 {
+		return
+	}
+
+	configHash, err := getDiskCacheConfigHash(config)
+	if err != nil {
+		logger.Warn("setDiskCache", "kind", kind, "getDiskCacheConfigHash.Error", err)
+		return
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		logger.Warn("setDiskCache", "kind", kind, "Marshal.Error", err)
+		return
+	}
+	data, err = json.Marshal(diskCacheEntry{ConfigHash: configHash, WrittenAt: time.Now(), Value: data})
+	if err != nil {
+		logger.Warn("setDiskCache", "kind", kind, "Marshal.Error", err)
+		return
+	}
+
+	// write through a temporary file, so concurrent readers never see a partial entry
+	path := getDiskCachePath(d.Connection.Name, getTenancyName(ctx), kind, region)
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		logger.Warn("setDiskCache", "kind", kind, "MkdirAll.Error", err)
+		return
+	}
+	file, err := os.CreateTemp(filepath.Dir(path), ".entry-*")
+	if err != nil {
+		logger.Warn("setDiskCache", "kind", kind, "CreateTemp.Error", err)
+		return
+	}
+	defer os.Remove(file.Name())
+	_, err = file.Write(data)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(file.Name(), path)
+	}
+	if err != nil {
+		logger.Warn("setDiskCache", "kind", kind, "Write.Error", err)
+	}
+}
@@ -0,0 +1,176 @@
+package oci
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-oci/oci/ocitest"
+)
+
+func TestGetDiskCacheTTL(t *testing.T) {
+	tests := []struct {
+		name      string
+		diskCache bool
+		ttls      []string
+		kind      string
+		want      time.Duration
+		err       bool
+	}{
+		{"disabled", false, nil, diskCacheCompartments, 0, false},
+		{"disabled with TTLs", false, []string{"compartments=15m"}, diskCacheCompartments, 0, false},
+		{"default", true, nil, diskCacheZones, 24 * time.Hour, false},
+		{"TTL of the kind", true, []string{"compartments=15m", "zones=168h"}, diskCacheZones, 168 * time.Hour, false},
+		{"TTL of another kind", true, []string{"compartments=15m"}, diskCacheNamespace, 24 * time.Hour, false},
+		{"spaces", true, []string{" compartments = 15m "}, diskCacheCompartments, 15 * time.Minute, false},
+		{"disabled kind", true, []string{"cloud_guard_configuration=0"}, diskCacheCloudGuardConfiguration, 0, false},
+		{"missing duration", true, []string{"compartments"}, diskCacheCompartments, 0, true},
+		{"unknown kind", true, []string{"regions=1h"}, diskCacheCompartments, 0, true},
+		{"invalid duration", true, []string{"zones=1 day"}, diskCacheCompartments, 0, true},
+		{"negative duration", true, []string{"zones=-1h"}, diskCacheCompartments, 0, true},
+		{"invalid TTL of a disabled cache", false, []string{"zones=1x"}, diskCacheCompartments, 0, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			config := ociConfig{DiskCache: types.Bool(test.diskCache), DiskCacheTTLs: test.ttls}
+			ttl, err := getDiskCacheTTL(config, test.kind)
+			if test.err {
+				if err == nil || !strings.Contains(err.Error(), "Edit your connection configuration file") {
+					t.Errorf("getDiskCacheTTL() error = %v, want a connection config error", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if ttl != test.want {
+				t.Errorf("getDiskCacheTTL() = %s, want %s", ttl, test.want)
+			}
+		})
+	}
+}
+
+func TestDiskCache(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("STEAMPIPE_INSTALL_DIR", dir)
+	keyPath := filepath.Join(dir, "key.pem")
+	if err := os.WriteFile(keyPath, []byte("key 1"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	config := fmt.Sprintf(`tenancy_ocid     = %q
+private_key_path = %q
+config_path      = %q
+disk_cache       = true
+`, ocitest.TenancyOCID, keyPath, filepath.Join(dir, "config"))
+
+	ctx := newTestContext()
+	d := newTestQueryData(t, config)
+	setDiskCache(ctx, d, diskCacheZones, "us-ashburn-1", []string{"AD-1", "AD-2"})
+
+	get := func(t *testing.T, config string, region string) bool {
+		t.Helper()
+		zones, ok := getDiskCache[[]string](ctx, newTestQueryData(t, config), diskCacheZones, region)
+		if ok && strings.Join(zones, ",") != "AD-1,AD-2" {
+			t.Errorf("getDiskCache() = %v, want the cached zones", zones)
+		}
+		return ok
+	}
+
+	if !get(t, config, "us-ashburn-1") {
+		t.Fatal("getDiskCache() missed the cached zones")
+	}
+	if get(t, config, "eu-frankfurt-1") {
+		t.Error("getDiskCache() read the zones of another region")
+	}
+	if _, ok := getDiskCache[[]string](ctx, d, diskCacheCompartments, "us-ashburn-1"); ok {
+		t.Error("getDiskCache() read another kind of data")
+	}
+
+	// a change of the connection config misses
+	if get(t, config+"regions = [\"us-ashburn-1\"]\n", "us-ashburn-1") {
+		t.Error("getDiskCache() read the zones of another connection config")
+	}
+	if get(t, strings.Replace(config, "disk_cache ", "disk_cache_ttls = [\"compartments=15m\"]\ndisk_cache ", 1), "us-ashburn-1") {
+		t.Error("getDiskCache() read the zones of other TTLs")
+	}
+
+	// a change of the key or config files misses
+	if err := os.WriteFile(keyPath, []byte("key 2"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if get(t, config, "us-ashburn-1") {
+		t.Error("getDiskCache() read the zones of another private key")
+	}
+	if err := os.WriteFile(keyPath, []byte("key 1"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if !get(t, config, "us-ashburn-1") {
+		t.Error("getDiskCache() missed the zones of the restored private key")
+	}
+	if err := os.WriteFile(filepath.Join(dir, "config"), []byte("[DEFAULT]\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if get(t, config, "us-ashburn-1") {
+		t.Error("getDiskCache() read the zones of another OCI config file")
+	}
+	if err := os.Remove(filepath.Join(dir, "config")); err != nil {
+		t.Fatal(err)
+	}
+
+	// entries older than the TTL of the kind miss
+	path := getDiskCachePath("oci", "", diskCacheZones, "us-ashburn-1")
+	age := func(t *testing.T, age time.Duration) {
+		t.Helper()
+		data, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var entry diskCacheEntry
+		if err := json.Unmarshal(data, &entry); err != nil {
+			t.Fatal(err)
+		}
+		entry.WrittenAt = time.Now().Add(-age)
+		if data, err = json.Marshal(entry); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, data, 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	age(t, 23*time.Hour)
+	if !get(t, config, "us-ashburn-1") {
+		t.Error("getDiskCache() missed zones within their TTL")
+	}
+	age(t, 25*time.Hour)
+	if get(t, config, "us-ashburn-1") {
+		t.Error("getDiskCache() read expired zones")
+	}
+
+	// nothing is cached once the cache is disabled
+	disabled := strings.Replace(config, "disk_cache       = true", "disk_cache       = false", 1)
+	setDiskCache(ctx, newTestQueryData(t, disabled), diskCacheNamespace, "", "namespace")
+	if _, err := os.Stat(getDiskCachePath("oci", "", diskCacheNamespace, "")); !os.IsNotExist(err) {
+		t.Errorf("setDiskCache() of a disabled cache wrote a cache file: %v", err)
+	}
+}
+
+func TestDiskCacheInvalidTTLs(t *testing.T) {
+	server, err := ocitest.NewServer(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer server.Close()
+	d := newTestQueryData(t, server.Config()+"disk_cache_ttls = [\"zones=1 day\"]\n")
+
+	// invalid TTLs fail the sessions of the connection, even though the disk cache is disabled
+	_, err = identityService(newTestContext(), d)
+	if err == nil || !strings.Contains(err.Error(), "invalid disk cache TTL 'zones=1 day'") {
+		t.Errorf("identityService() error = %v, want the invalid disk cache TTL", err)
+	}
+}
@@ -0,0 +1,68 @@
+package oci
+
+import (
+	"fmt"
+	"strings"
+
+	oci_common "github.com/oracle/oci-go-sdk/v44/common"
+)
+
+/*
+Each entry of the `endpoints` list overrides the endpoint of a service, using
+the service names of the `serviceClient` definitions in service.go:
+
+	endpoints = [
+		"compute=https://iaas.{region}.private.example.com",
+		"object_storage=http://localhost:8080",
+	]
+
+The `{region}` placeholder is replaced with the region of the client, and
+`{realm_domain}` with the `realm_domain` setting or the domain of the realm the
+region belongs to.
+*/
+func getEndpointOverrides(config ociConfig) (map[string]string, error) {
+	overrides := map[string]string{}
+	for _, entry := range config.Endpoints {
+		kv := strings.SplitN(entry, "=", 2)
+		if len(kv) != 2 || strings.TrimSpace(kv[0]) == "" || strings.TrimSpace(kv[1]) == "" {
+			return nil, fmt.Errorf("connection config has invalid endpoint '%s', expected service=url. Edit your connection configuration file and then restart Steampipe", entry)
+		}
+		overrides[strings.TrimSpace(kv[0])] = strings.TrimSpace(kv[1])
+	}
+	return overrides, nil
+}
+
+// getRealmDomain returns the second level domain of the realm a region belongs to, e.g. oraclecloud.com
+func getRealmDomain(region string) string {
+	return oci_common.StringToRegion(region).EndpointForTemplate("", "{secondLevelDomain}")
+}
+
+// getServiceEndpoint returns the endpoint configured for a service through the
+// `endpoints` and `realm_domain` connection settings, or an empty string if the
+// default endpoint of the SDK client should be kept
+func getServiceEndpoint(config ociConfig, service string, region string, host string) (string, error) {
+	overrides, err := getEndpointOverrides(config)
+	if err != nil {
+		return "", err
+	}
+
+	realmDomain := getRealmDomain(region)
+	if config.RealmDomain != nil && *config.RealmDomain != "" {
+		realmDomain = strings.Trim(*config.RealmDomain, ".")
+	}
+
+	if template, ok := overrides[service]; ok {
+		endpoint := strings.ReplaceAll(template, "{region}", region)
+		endpoint = strings.ReplaceAll(endpoint, "{realm_domain}", realmDomain)
+		return endpoint, nil
+	}
+
+	// swap the realm domain of the default endpoint, e.g. iaas.us-ashburn-1.oraclecloud.com
+	if config.RealmDomain != nil && *config.RealmDomain != "" {
+		if defaultDomain := getRealmDomain(region); strings.HasSuffix(host, "."+defaultDomain) {
+			return strings.TrimSuffix(host, defaultDomain) + realmDomain, nil
+		}
+	}
+
+	return "", nil
+}
@@ -0,0 +1,172 @@
+package oci
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/turbot/go-kit/helpers"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+)
+
+// a matrix with skipped items is cached for a shorter time, so unreachable regions are retried sooner
+const skippedMatrixCacheTTL = 5 * time.Minute
+
+// matrixError is returned when a table matrix could not be built
+type matrixError struct {
+	Matrix  string
+	Tenancy string
+	Region  string
+	Err     error
+}
+
+func (e *matrixError) Error() string {
+	scope := []string{}
+	if e.Tenancy != "" {
+		scope = append(scope, "tenancy "+e.Tenancy)
+	}
+	if e.Region != "" {
+		scope = append(scope, "region "+e.Region)
+	}
+	if len(scope) == 0 {
+		return fmt.Sprintf("%s: %s", e.Matrix, e.Err.Error())
+	}
+	return fmt.Sprintf("%s (%s): %s", e.Matrix, strings.Join(scope, ", "), e.Err.Error())
+}
+
+func (e *matrixError) Unwrap() error {
+	return e.Err
+}
+
+// skippedRegion is a tenancy or tenancy-region left out of a table matrix because it could not be listed
+type skippedRegion struct {
+	Matrix       string
+	Tenancy      string
+	Region       string
+	ErrorCode    string
+	ErrorMessage string
+	SkippedAt    time.Time
+}
+
+var skippedRegions = map[string][]skippedRegion{}
+var skippedRegionsLock sync.Mutex
+
+// setSkippedRegions replaces the skipped regions recorded for the given matrix of a connection
+func setSkippedRegions(connection string, matrix string, skipped []skippedRegion) {
+	skippedRegionsLock.Lock()
+	defer skippedRegionsLock.Unlock()
+
+	regions := []skippedRegion{}
+	for _, item := range skippedRegions[connection] {
+		if item.Matrix != matrix {
+			regions = append(regions, item)
+		}
+	}
+	skippedRegions[connection] = append(regions, skipped...)
+}
+
+// getSkippedRegions returns the skipped regions recorded for a connection
+func getSkippedRegions(connection string) []skippedRegion {
+	skippedRegionsLock.Lock()
+	defer skippedRegionsLock.Unlock()
+
+	return append([]skippedRegion{}, skippedRegions[connection]...)
+}
+
+// isSkippableMatrixError returns true for errors which only affect a single
+// tenancy or region, i.e. missing authorization, expired credentials or an
+// unreachable region endpoint
+func isSkippableMatrixError(err error) bool {
+	if serviceErr, ok := getServiceError(err); ok {
+		return helpers.StringSliceContains([]string{"NotAuthenticated", "NotAuthorizedOrNotFound", "NotAuthorized"}, serviceErr.GetCode()) ||
+			serviceErr.GetHTTPStatusCode() == 401 || serviceErr.GetHTTPStatusCode() == 403
+	}
+
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+// getErrorCode returns the OCI error code of an error, or a generic code for non service errors
+func getErrorCode(err error) string {
+	if serviceErr, ok := getServiceError(err); ok {
+		return serviceErr.GetCode()
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		if netErr.Timeout() {
+			return "Timeout"
+		}
+		return "Unreachable"
+	}
+	return "Unknown"
+}
+
+// matrixBuilder collects the items of a table matrix, skipping the tenancies and
+// regions which fail with a skippable error
+type matrixBuilder struct {
+	name     string
+	items    []map[string]interface{}
+	skipped  []skippedRegion
+	firstErr error
+}
+
+func newMatrixBuilder(name string) *matrixBuilder {
+	return &matrixBuilder{name: name, items: []map[string]interface{}{}}
+}
+
+// add appends an item to the matrix
+func (b *matrixBuilder) add(item map[string]interface{}) {
+	b.items = append(b.items, item)
+}
+
+// skip records a tenancy (or a region of a tenancy) that could not be listed.
+// Errors which are not skippable are returned as a matrixError.
+func (b *matrixBuilder) skip(ctx context.Context, tenancy string, region string, err error) error {
+	if strings.Contains(err.Error(), "proper configuration for region") || strings.Contains(err.Error(), "OCI_REGION") {
+		return &matrixError{Matrix: b.name, Tenancy: tenancy, Region: region, Err: errors.New("'regions' must be set in the connection configuration. Edit your connection configuration file and then restart Steampipe")}
+	}
+	if !isSkippableMatrixError(err) {
+		return &matrixError{Matrix: b.name, Tenancy: tenancy, Region: region, Err: err}
+	}
+
+	plugin.Logger(ctx).Warn(b.name, "tenancy", tenancy, "region", region, "skipped", err)
+	if b.firstErr == nil {
+		b.firstErr = &matrixError{Matrix: b.name, Tenancy: tenancy, Region: region, Err: err}
+	}
+	b.skipped = append(b.skipped, skippedRegion{
+		Matrix:       b.name,
+		Tenancy:      tenancy,
+		Region:       region,
+		ErrorCode:    getErrorCode(err),
+		ErrorMessage: err.Error(),
+		SkippedAt:    time.Now(),
+	})
+	return nil
+}
+
+// build records the skipped regions and caches the matrix. If every tenancy
+// and region was skipped the first error is returned, as nothing can be listed.
+func (b *matrixBuilder) build(d *plugin.QueryData, cacheKey string) ([]map[string]interface{}, error) {
+	setSkippedRegions(d.Connection.Name, b.name, b.skipped)
+
+	if len(b.skipped) == 0 {
+		d.ConnectionManager.Cache.Set(cacheKey, b.items)
+		return b.items, nil
+	}
+
+	if len(b.items) == 0 {
+		return nil, b.firstErr
+	}
+
+	d.ConnectionManager.Cache.SetWithTTL(cacheKey, b.items, skippedMatrixCacheTTL)
+	return b.items, nil
+}
@@ -2,19 +2,38 @@ package oci
 
 import (
 	"context"
+	"fmt"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/oracle/oci-go-sdk/v44/common"
 	"github.com/oracle/oci-go-sdk/v44/monitoring"
+	"github.com/turbot/go-kit/types"
 	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
 )
 
+// MonitoringMetricKeyColumns returns the key columns of the metric tables. The
+// timestamp quals set the time range of the metric query.
+func MonitoringMetricKeyColumns() []*plugin.KeyColumn {
+	return []*plugin.KeyColumn{
+		{
+			Name:    "compartment_id",
+			Require: plugin.Optional,
+		},
+		{
+			Name:      "timestamp",
+			Require:   plugin.Optional,
+			Operators: []string{">", ">=", "=", "<", "<="},
+		},
+	}
+}
+
 // append the common metric columns onto the column list
 func MonitoringMetricColumns(columns []*plugin.Column) []*plugin.Column {
-	return append(columns, commonMonitoringMetricColumns()...)
+	return CompartmentColumns(append(columns, commonMonitoringMetricColumns()...))
 }
 
 func commonMonitoringMetricColumns() []*plugin.Column {
@@ -54,6 +73,31 @@ func commonMonitoringMetricColumns() []*plugin.Column {
 			Description: "The sum of the metric values for the data point.",
 			Type:        proto.ColumnType_DOUBLE,
 		},
+		{
+			Name:        "p50",
+			Description: "The 50th percentile of the metric values for the data point. Only queried when selected.",
+			Type:        proto.ColumnType_DOUBLE,
+		},
+		{
+			Name:        "p90",
+			Description: "The 90th percentile of the metric values for the data point. Only queried when selected.",
+			Type:        proto.ColumnType_DOUBLE,
+		},
+		{
+			Name:        "p95",
+			Description: "The 95th percentile of the metric values for the data point. Only queried when selected.",
+			Type:        proto.ColumnType_DOUBLE,
+		},
+		{
+			Name:        "p99",
+			Description: "The 99th percentile of the metric values for the data point. Only queried when selected.",
+			Type:        proto.ColumnType_DOUBLE,
+		},
+		{
+			Name:        "rate",
+			Description: "The per-second rate of change of the metric values for the data point. Only queried when selected.",
+			Type:        proto.ColumnType_DOUBLE,
+		},
 		{
 			Name:        "unit",
 			Description: "The standard unit for the data point.",
@@ -80,7 +124,7 @@ func commonMonitoringMetricColumns() []*plugin.Column {
 			Name:        "tenant_id",
 			Description: ColumnDescriptionTenant,
 			Type:        proto.ColumnType_STRING,
-			Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
+			Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(tenancyCacheKey("getTenantId")),
 			Transform:   transform.FromValue(),
 		},
 	}
@@ -118,6 +162,15 @@ type MonitoringMetricRow struct {
 	// The sum of the metric values for the data point.
 	Sum *float64
 
+	// The percentiles of the metric values for the data point.
+	P50 *float64
+	P90 *float64
+	P95 *float64
+	P99 *float64
+
+	// The per-second rate of change of the metric values for the data point.
+	Rate *float64
+
 	// The time stamp used for the data point.
 	Timestamp *time.Time
 
@@ -129,6 +182,9 @@ type MonitoringMetricRow struct {
 	Region string
 }
 
+// maximum number of data points returned by a single SummarizeMetricsData call
+const monitoringMaxDatapoints = 100000
+
 func getMonitoringStartDateForGranularity(granularity string) time.Time {
 	switch strings.ToUpper(granularity) {
 	case "DAILY":
@@ -155,138 +211,358 @@ func getMonitoringPeriodForGranularity(granularity string) string {
 	return "5m"
 }
 
-type MetricData struct {
-	CompartmentId *string
-	PointValue    *float64
-	Timestamp     *time.Time
+func getMonitoringPeriodDurationForGranularity(granularity string) time.Duration {
+	switch strings.ToUpper(granularity) {
+	case "DAILY":
+		return 24 * time.Hour
+	case "HOURLY":
+		return time.Hour
+	}
+	return 5 * time.Minute
+}
+
+// getMonitoringMaxRangeForGranularity returns the longest time range a single
+// query returns data for at the period of the granularity
+func getMonitoringMaxRangeForGranularity(granularity string) time.Duration {
+	switch strings.ToUpper(granularity) {
+	case "DAILY", "HOURLY":
+		// 90 days
+		return 90 * 24 * time.Hour
+	}
+	// else 30 days
+	return 30 * 24 * time.Hour
 }
 
-func listMonitoringMetricStatistics(ctx context.Context, d *plugin.QueryData, granularity string, namespace string, metricName string, dimensionName string, dimensionValue string, compartmentId string, region string) (*monitoring.SummarizeMetricsDataResponse, error) {
-	plugin.Logger(ctx).Trace("listMonitoringMetricStatistics")
+type monitoringTimeRange struct {
+	StartTime time.Time
+	EndTime   time.Time
+}
 
-	// Create Session
-	session, err := monitoringService(ctx, d, region)
-	if err != nil {
-		return nil, err
+/*
+getMonitoringTimeRange returns the time range of the data points of a metric
+query. Without timestamp quals it is the default range of the granularity,
+ending now:
+
+	timestamp >= now() - interval '1 day'                -- the last day
+	timestamp between '2022-06-01' and '2022-06-30'      -- June 2022
+	timestamp <= now() - interval '7 days'               -- the default range, ending a week ago
+
+The end of the range is exclusive, so the range ends one millisecond after
+the upper bound of <= quals. A single timestamp, e.g. timestamp = X, covers
+one period of the granularity, so the query has a full aggregation window.
+*/
+func getMonitoringTimeRange(d *plugin.QueryData, granularity string) monitoringTimeRange {
+	var startTime, endTime *time.Time
+
+	if d.Quals["timestamp"] != nil {
+		for _, q := range d.Quals["timestamp"].Quals {
+			timestamp := q.Value.GetTimestampValue().AsTime()
+			lower, upper := timestamp, timestamp
+			switch q.Operator {
+			case "=":
+				upper = timestamp.Add(getMonitoringPeriodDurationForGranularity(granularity))
+			case ">", ">=":
+				upper = time.Time{}
+			case "<":
+				lower = time.Time{}
+			case "<=":
+				lower, upper = time.Time{}, timestamp.Add(time.Millisecond)
+			}
+			// multiple quals narrow the range
+			if !lower.IsZero() && (startTime == nil || lower.After(*startTime)) {
+				startTime = &lower
+			}
+			if !upper.IsZero() && (endTime == nil || upper.Before(*endTime)) {
+				endTime = &upper
+			}
+		}
 	}
 
-	/**
-	DEFINE QUERY STRING
-	metric[interval]{dimensionname="dimensionvalue"}.groupingfunction.statistic
-	Query should be written with Metric query Language (MQL) https://docs.oracle.com/en-us/iaas/Content/Monitoring/Reference/mql.htm#Interval
-	*/
-	queryString := metricName + "[" + getMonitoringPeriodForGranularity(granularity) + "]" + "{" + dimensionName + " = \"" + dimensionValue + "\"}"
-	queryStringavg := queryString + ".grouping().mean()"
-	querystringMin := queryString + ".grouping().min()"
-	querystringMax := queryString + ".grouping().max()"
-	querystringSum := queryString + ".grouping().sum()"
-	querystringCount := queryString + ".grouping().count()"
-
-	// Set Inteval
-	interval := getMonitoringPeriodForGranularity(granularity)
-	metricDetails := monitoring.SummarizeMetricsDataDetails{
-		Namespace:  &namespace,
-		StartTime:  &common.SDKTime{Time: getMonitoringStartDateForGranularity(granularity)},
-		EndTime:    &common.SDKTime{Time: time.Now()},
-		Query:      &queryStringavg,
-		Resolution: &interval,
+	timeRange := monitoringTimeRange{
+		StartTime: getMonitoringStartDateForGranularity(granularity),
+		EndTime:   time.Now(),
+	}
+	if endTime != nil {
+		// keep the length of the default range
+		timeRange.StartTime = endTime.Add(-timeRange.EndTime.Sub(timeRange.StartTime))
+		timeRange.EndTime = *endTime
+	}
+	if startTime != nil {
+		timeRange.StartTime = *startTime
+	}
+	return timeRange
+}
+
+// splitMonitoringTimeRange splits a time range into chunks which a single
+// query returns all data points of, for the given number of metric streams
+func splitMonitoringTimeRange(timeRange monitoringTimeRange, granularity string, streams int) []monitoringTimeRange {
+	chunk := getMonitoringMaxRangeForGranularity(granularity)
+	if streams < 1 {
+		streams = 1
+	}
+	if datapoints := time.Duration(monitoringMaxDatapoints/streams) * getMonitoringPeriodDurationForGranularity(granularity); datapoints < chunk {
+		chunk = datapoints
 	}
 
-	requestParam := monitoring.SummarizeMetricsDataRequest{
-		CompartmentId:               &compartmentId,
-		SummarizeMetricsDataDetails: metricDetails,
-		RequestMetadata: common.RequestMetadata{
-			RetryPolicy: getDefaultRetryPolicy(d.Connection),
-		},
+	chunks := []monitoringTimeRange{}
+	for start := timeRange.StartTime; start.Before(timeRange.EndTime); start = start.Add(chunk) {
+		end := start.Add(chunk)
+		if end.After(timeRange.EndTime) {
+			end = timeRange.EndTime
+		}
+		chunks = append(chunks, monitoringTimeRange{StartTime: start, EndTime: end})
 	}
+	return chunks
+}
 
-	// Mean statistics
-	avgStatistics, err := session.MonitoringClient.SummarizeMetricsData(ctx, requestParam)
-	if err != nil {
-		return nil, err
+// monitoringStatistic is a statistic column of the metric tables, and the MQL function which aggregates it
+type monitoringStatistic struct {
+	Column   string
+	Function string
+	set      func(row *MonitoringMetricRow, value *float64)
+}
+
+var monitoringStatistics = []monitoringStatistic{
+	{
+		Column:   "average",
+		Function: "mean()",
+		set:      func(row *MonitoringMetricRow, value *float64) { row.Average = value },
+	},
+	{
+		Column:   "maximum",
+		Function: "max()",
+		set:      func(row *MonitoringMetricRow, value *float64) { row.Maximum = value },
+	},
+	{
+		Column:   "minimum",
+		Function: "min()",
+		set:      func(row *MonitoringMetricRow, value *float64) { row.Minimum = value },
+	},
+	{
+		Column:   "sum",
+		Function: "sum()",
+		set:      func(row *MonitoringMetricRow, value *float64) { row.Sum = value },
+	},
+	{
+		Column:   "sample_count",
+		Function: "count()",
+		set:      func(row *MonitoringMetricRow, value *float64) { row.SampleCount = value },
+	},
+	{
+		Column:   "p50",
+		Function: "percentile(0.5)",
+		set:      func(row *MonitoringMetricRow, value *float64) { row.P50 = value },
+	},
+	{
+		Column:   "p90",
+		Function: "percentile(0.9)",
+		set:      func(row *MonitoringMetricRow, value *float64) { row.P90 = value },
+	},
+	{
+		Column:   "p95",
+		Function: "percentile(0.95)",
+		set:      func(row *MonitoringMetricRow, value *float64) { row.P95 = value },
+	},
+	{
+		Column:   "p99",
+		Function: "percentile(0.99)",
+		set:      func(row *MonitoringMetricRow, value *float64) { row.P99 = value },
+	},
+	{
+		Column:   "rate",
+		Function: "rate()",
+		set:      func(row *MonitoringMetricRow, value *float64) { row.Rate = value },
+	},
+}
+
+// getMonitoringStatistics returns the statistics of the columns the query
+// projects. Queries without statistic columns still need the data points, so
+// they query the mean.
+func getMonitoringStatistics(d *plugin.QueryData) []monitoringStatistic {
+	columns := map[string]bool{}
+	for _, column := range d.QueryContext.Columns {
+		columns[column] = true
 	}
 
-	// Min statistics
-	metricDetails.Query = &querystringMin
-	requestParam.SummarizeMetricsDataDetails = metricDetails
-	minStatistics, err := session.MonitoringClient.SummarizeMetricsData(ctx, requestParam)
-	if err != nil {
-		return nil, err
+	statistics := []monitoringStatistic{}
+	for _, statistic := range monitoringStatistics {
+		if columns[statistic.Column] {
+			statistics = append(statistics, statistic)
+		}
 	}
-	metricDetailsMin := filterMetricStatistic(minStatistics)
+	if len(statistics) == 0 {
+		statistics = append(statistics, monitoringStatistics[0])
+	}
+	return statistics
+}
 
-	// Max statistics
-	metricDetails.Query = &querystringMax
-	requestParam.SummarizeMetricsDataDetails = metricDetails
-	maxStatistics, err := session.MonitoringClient.SummarizeMetricsData(ctx, requestParam)
-	if err != nil {
-		return nil, err
+// maximum number of resources queried at once, which keeps MQL queries short
+const monitoringMaxStreamsPerQuery = 50
+
+/*
+listMonitoringMetricStatistics streams the data points of a metric of the
+resources of the matrix compartment and region, one row per resource and
+timestamp. The resources are the values of a dimension of the metric, e.g.
+resourceId, which start with dimensionValuePrefix, e.g. ocid1.bootvolume. to
+skip the block volumes of the oci_blockstore namespace.
+
+The resources are queried in batches, once for each statistic the query
+projects:
+
+	CpuUtilization[5m]{resourceId =~ "ocid1.instance.oc1.iad.aaa|ocid1.instance.oc1.iad.bbb"}.groupBy(resourceId).max()
+*/
+func listMonitoringMetricStatistics(ctx context.Context, d *plugin.QueryData, granularity string, namespace string, metricName string, dimensionName string, dimensionValuePrefix string) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	region := plugin.GetMatrixItem(ctx)[matrixKeyRegion].(string)
+	compartment := plugin.GetMatrixItem(ctx)[matrixKeyCompartment].(string)
+	logger.Debug("listMonitoringMetricStatistics", "Compartment", compartment, "OCI_REGION", region, "Metric", metricName)
+
+	equalQuals := d.KeyColumnQuals
+
+	// Return nil, if given compartment_id doesn't match
+	if equalQuals["compartment_id"] != nil && compartment != equalQuals["compartment_id"].GetStringValue() {
+		return nil, nil
 	}
-	metricDetailsMax := filterMetricStatistic(maxStatistics)
 
-	// Sum statistics
-	metricDetails.Query = &querystringSum
-	requestParam.SummarizeMetricsDataDetails = metricDetails
-	sumStatistics, err := session.MonitoringClient.SummarizeMetricsData(ctx, requestParam)
+	// Create Session
+	session, err := monitoringService(ctx, d, region)
 	if err != nil {
 		return nil, err
 	}
-	metricDetailsSum := filterMetricStatistic(sumStatistics)
 
-	// Count statistics
-	metricDetails.Query = &querystringCount
-	requestParam.SummarizeMetricsDataDetails = metricDetails
-	countStatistics, err := session.MonitoringClient.SummarizeMetricsData(ctx, requestParam)
+	values, err := listMonitoringMetricDimensionValues(ctx, d, session, compartment, namespace, metricName, dimensionName, dimensionValuePrefix)
 	if err != nil {
 		return nil, err
 	}
-	metricDetailsCount := filterMetricStatistic(countStatistics)
-
-	for _, item := range avgStatistics.Items {
-		for _, datapoint := range item.AggregatedDatapoints {
-			d.StreamLeafListItem(ctx, &MonitoringMetricRow{
-				CompartmentId:  item.CompartmentId,
-				DimensionValue: &dimensionValue,
-				DimensionName:  &dimensionName,
-				Namespace:      &namespace,
-				MetricName:     &metricName,
-				Average:        datapoint.Value,
-				Maximum:        getStatisticForColumnByTimestamp(datapoint.Timestamp.Time.UTC(), *item.CompartmentId, metricDetailsMax),
-				Minimum:        getStatisticForColumnByTimestamp(datapoint.Timestamp.Time.UTC(), *item.CompartmentId, metricDetailsMin),
-				Timestamp:      &datapoint.Timestamp.Time,
-				SampleCount:    getStatisticForColumnByTimestamp(datapoint.Timestamp.Time.UTC(), *item.CompartmentId, metricDetailsCount),
-				Sum:            getStatisticForColumnByTimestamp(datapoint.Timestamp.Time.UTC(), *item.CompartmentId, metricDetailsSum),
-				Metadata:       item.Metadata,
-				Region:         region,
-			})
+
+	interval := getMonitoringPeriodForGranularity(granularity)
+	timeRange := getMonitoringTimeRange(d, granularity)
+	statistics := getMonitoringStatistics(d)
+
+	for start := 0; start < len(values); start += monitoringMaxStreamsPerQuery {
+		end := start + monitoringMaxStreamsPerQuery
+		if end > len(values) {
+			end = len(values)
+		}
+		batch := values[start:end]
+
+		// rows of the batch by dimension value and timestamp, which the statistics are joined on
+		rows := map[string]map[int64]*MonitoringMetricRow{}
+
+		for _, chunk := range splitMonitoringTimeRange(timeRange, granularity, len(batch)) {
+			for _, statistic := range statistics {
+				/**
+				DEFINE QUERY STRING
+				metric[interval]{dimensionname =~ "value1|value2"}.groupBy(dimensionname).statistic
+				Query should be written with Metric query Language (MQL) https://docs.oracle.com/en-us/iaas/Content/Monitoring/Reference/mql.htm
+				*/
+				query := fmt.Sprintf("%s[%s]{%s =~ \"%s\"}.groupBy(%s).%s", metricName, interval, dimensionName, strings.Join(batch, "|"), dimensionName, statistic.Function)
+
+				request := monitoring.SummarizeMetricsDataRequest{
+					CompartmentId: types.String(compartment),
+					SummarizeMetricsDataDetails: monitoring.SummarizeMetricsDataDetails{
+						Namespace:  types.String(namespace),
+						Query:      types.String(query),
+						StartTime:  &common.SDKTime{Time: chunk.StartTime},
+						EndTime:    &common.SDKTime{Time: chunk.EndTime},
+						Resolution: types.String(interval),
+					},
+					RequestMetadata: common.RequestMetadata{
+						RetryPolicy: getDefaultRetryPolicy(d.Connection),
+					},
+				}
+
+				response, err := session.MonitoringClient.SummarizeMetricsData(ctx, request)
+				if err != nil {
+					return nil, wrapServiceError(err, session, "SummarizeMetricsData")
+				}
+
+				for _, item := range response.Items {
+					value, ok := item.Dimensions[dimensionName]
+					if !ok {
+						continue
+					}
+					if rows[value] == nil {
+						rows[value] = map[int64]*MonitoringMetricRow{}
+					}
+					for _, datapoint := range item.AggregatedDatapoints {
+						timestamp := datapoint.Timestamp.Time
+						row := rows[value][timestamp.UnixNano()]
+						if row == nil {
+							row = &MonitoringMetricRow{
+								CompartmentId:  item.CompartmentId,
+								DimensionName:  types.String(dimensionName),
+								DimensionValue: types.String(value),
+								Namespace:      types.String(namespace),
+								MetricName:     types.String(metricName),
+								Timestamp:      &timestamp,
+								Metadata:       item.Metadata,
+								Region:         region,
+							}
+							rows[value][timestamp.UnixNano()] = row
+						}
+						statistic.set(row, datapoint.Value)
+					}
+				}
+			}
+		}
+
+		// Stream the rows by resource and timestamp
+		for _, value := range batch {
+			timestamps := make([]int64, 0, len(rows[value]))
+			for timestamp := range rows[value] {
+				timestamps = append(timestamps, timestamp)
+			}
+			sort.Slice(timestamps, func(i, j int) bool { return timestamps[i] < timestamps[j] })
+
+			for _, timestamp := range timestamps {
+				d.StreamListItem(ctx, rows[value][timestamp])
+
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
 		}
 	}
 
-	return nil, err
+	return nil, nil
 }
 
-func filterMetricStatistic(metricStatistic monitoring.SummarizeMetricsDataResponse) []MetricData {
-	metricData := []MetricData{}
-	for _, item := range metricStatistic.Items {
-		for _, data := range item.AggregatedDatapoints {
-			metricData = append(metricData, MetricData{
-				CompartmentId: item.CompartmentId,
-				PointValue:    data.Value,
-				Timestamp:     &data.Timestamp.Time,
-			})
-		}
+// listMonitoringMetricDimensionValues returns the values of a dimension of a
+// metric of a compartment which start with a prefix, e.g. the OCIDs of the
+// resources which emit the metric
+func listMonitoringMetricDimensionValues(ctx context.Context, d *plugin.QueryData, session *session, compartment string, namespace string, metricName string, dimensionName string, prefix string) ([]string, error) {
+	request := monitoring.ListMetricsRequest{
+		CompartmentId: types.String(compartment),
+		ListMetricsDetails: monitoring.ListMetricsDetails{
+			Namespace: types.String(namespace),
+			Name:      types.String(metricName),
+			GroupBy:   []string{dimensionName},
+		},
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
 	}
-	return metricData
-}
 
-func getStatisticForColumnByTimestamp(timestamp time.Time, compartmentId string, metricData []MetricData) *float64 {
-	var value *float64
-	for _, t := range metricData {
-		if *t.Timestamp == timestamp && compartmentId == *t.CompartmentId {
-			value = t.PointValue
-			break
+	values := []string{}
+	pagesLeft := true
+	for pagesLeft {
+		response, err := session.MonitoringClient.ListMetrics(ctx, request)
+		if err != nil {
+			return nil, wrapServiceError(err, session, "ListMetrics")
 		}
-	}
 
-	return value
+		for _, metric := range response.Items {
+			if value := metric.Dimensions[dimensionName]; value != "" && strings.HasPrefix(value, prefix) {
+				values = append(values, value)
+			}
+		}
+		if response.OpcNextPage != nil {
+			request.Page = response.OpcNextPage
+		} else {
+			pagesLeft = false
+		}
+	}
+	return values, nil
 }
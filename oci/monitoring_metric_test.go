@@ -0,0 +1,164 @@
+package oci
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/quals"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type timestampQual struct {
+	operator  string
+	timestamp time.Time
+}
+
+// newTimestampQueryData returns the query data of a query with the given quals of a timestamp column
+func newTimestampQueryData(column string, timestampQuals []timestampQual) *plugin.QueryData {
+	d := &plugin.QueryData{Quals: plugin.KeyColumnQualMap{}}
+	if len(timestampQuals) == 0 {
+		return d
+	}
+	qualSlice := quals.QualSlice{}
+	for _, q := range timestampQuals {
+		qualSlice = append(qualSlice, &quals.Qual{
+			Column:   column,
+			Operator: q.operator,
+			Value:    &proto.QualValue{Value: &proto.QualValue_TimestampValue{TimestampValue: timestamppb.New(q.timestamp)}},
+		})
+	}
+	d.Quals[column] = &plugin.KeyColumnQuals{Name: column, Quals: qualSlice}
+	return d
+}
+
+func TestGetMonitoringTimeRange(t *testing.T) {
+	t1 := time.Date(2022, 6, 1, 10, 0, 0, 0, time.UTC)
+	t2 := time.Date(2022, 6, 30, 10, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+
+	// a zero end is now, and a zero start is the given length before the end,
+	// which are checked with a tolerance
+	tests := []struct {
+		name        string
+		granularity string
+		quals       []timestampQual
+		start       time.Time
+		end         time.Time
+		length      time.Duration
+	}{
+		{"default 5 minute range", "FIVE_MINUTES", nil, time.Time{}, time.Time{}, 5 * day},
+		{"default hourly range", "HOURLY", nil, time.Time{}, time.Time{}, 60 * day},
+		{"default daily range", "DAILY", nil, time.Time{}, time.Time{}, 90 * day},
+		{">= ends now", "HOURLY", []timestampQual{{">=", t1}}, t1, time.Time{}, 0},
+		{"> ends now", "HOURLY", []timestampQual{{">", t1}}, t1, time.Time{}, 0},
+		{"= covers a 5 minute period", "FIVE_MINUTES", []timestampQual{{"=", t1}}, t1, t1.Add(5 * time.Minute), 0},
+		{"= covers an hourly period", "HOURLY", []timestampQual{{"=", t1}}, t1, t1.Add(time.Hour), 0},
+		{"= covers a daily period", "DAILY", []timestampQual{{"=", t1}}, t1, t1.Add(day), 0},
+		{"< keeps the default length", "FIVE_MINUTES", []timestampQual{{"<", t2}}, time.Time{}, t2, 5 * day},
+		{"<= includes the bound", "HOURLY", []timestampQual{{"<=", t2}}, time.Time{}, t2.Add(time.Millisecond), 60 * day},
+		{"between", "HOURLY", []timestampQual{{">=", t1}, {"<=", t2}}, t1, t2.Add(time.Millisecond), 0},
+		{">= and <", "HOURLY", []timestampQual{{">=", t1}, {"<", t2}}, t1, t2, 0},
+		{"the latest lower bound narrows the range", "HOURLY", []timestampQual{{">=", t1}, {">", t1.Add(day)}, {"<", t2}}, t1.Add(day), t2, 0},
+		{"the earliest upper bound narrows the range", "HOURLY", []timestampQual{{">=", t1}, {"<", t2}, {"<=", t2.Add(-day)}}, t1, t2.Add(time.Millisecond - day), 0},
+		{"= and a later upper bound", "HOURLY", []timestampQual{{"=", t1}, {"<", t2}}, t1, t1.Add(time.Hour), 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			timeRange := getMonitoringTimeRange(newTimestampQueryData("timestamp", test.quals), test.granularity)
+			now := time.Now()
+
+			end := test.end
+			if end.IsZero() {
+				if now.Sub(timeRange.EndTime) > time.Minute || timeRange.EndTime.After(now) {
+					t.Errorf("end time = %s, want now", timeRange.EndTime)
+				}
+			} else if !timeRange.EndTime.Equal(end) {
+				t.Errorf("end time = %s, want %s", timeRange.EndTime, end)
+			}
+
+			start := test.start
+			if start.IsZero() {
+				start = timeRange.EndTime.Add(-test.length)
+				if diff := timeRange.StartTime.Sub(start); diff > time.Minute || diff < -time.Minute {
+					t.Errorf("start time = %s, want %s", timeRange.StartTime, start)
+				}
+			} else if !timeRange.StartTime.Equal(start) {
+				t.Errorf("start time = %s, want %s", timeRange.StartTime, start)
+			}
+		})
+	}
+}
+
+func TestSplitMonitoringTimeRange(t *testing.T) {
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+
+	tests := []struct {
+		name        string
+		granularity string
+		length      time.Duration
+		streams     int
+		chunks      []time.Duration
+	}{
+		{"one chunk", "FIVE_MINUTES", 10 * day, 1, []time.Duration{10 * day}},
+		{"5 minute range limit", "FIVE_MINUTES", 45 * day, 1, []time.Duration{30 * day, 15 * day}},
+		{"hourly range limit", "HOURLY", 100 * day, 1, []time.Duration{90 * day, 10 * day}},
+		{"daily range limit", "DAILY", 200 * day, 200, []time.Duration{90 * day, 90 * day, 20 * day}},
+		{"exact multiple of the chunk", "HOURLY", 180 * day, 1, []time.Duration{90 * day, 90 * day}},
+		// 100000 data points of 50 streams are 2000 periods of 5 minutes
+		{"data point limit", "FIVE_MINUTES", 10 * day, 50, []time.Duration{2000 * 5 * time.Minute, 10*day - 2000*5*time.Minute}},
+		{"data point limit at the chunk boundary", "HOURLY", 2000 * time.Hour, 100, []time.Duration{1000 * time.Hour, 1000 * time.Hour}},
+		{"data point limit with a partial chunk", "HOURLY", 100 * day, 100, []time.Duration{1000 * time.Hour, 1000 * time.Hour, 400 * time.Hour}},
+		{"no streams", "FIVE_MINUTES", 10 * day, 0, []time.Duration{10 * day}},
+		{"empty range", "HOURLY", 0, 1, []time.Duration{}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			chunks := splitMonitoringTimeRange(monitoringTimeRange{StartTime: start, EndTime: start.Add(test.length)}, test.granularity, test.streams)
+			if len(chunks) != len(test.chunks) {
+				t.Fatalf("got %d chunks %v, want %d", len(chunks), chunks, len(test.chunks))
+			}
+
+			// chunks are contiguous and cover the range
+			chunkStart := start
+			for i, chunk := range chunks {
+				if !chunk.StartTime.Equal(chunkStart) || chunk.EndTime.Sub(chunk.StartTime) != test.chunks[i] {
+					t.Errorf("chunk %d = %s - %s, want %s lasting %s", i, chunk.StartTime, chunk.EndTime, chunkStart, test.chunks[i])
+				}
+				chunkStart = chunk.EndTime
+			}
+			if len(chunks) > 0 && !chunkStart.Equal(start.Add(test.length)) {
+				t.Errorf("chunks end at %s, want %s", chunkStart, start.Add(test.length))
+			}
+		})
+	}
+}
+
+func TestGetMonitoringStatistics(t *testing.T) {
+	tests := []struct {
+		columns []string
+		want    []string
+	}{
+		{[]string{"id", "timestamp"}, []string{"mean()"}},
+		{[]string{"id", "average"}, []string{"mean()"}},
+		{[]string{"minimum", "maximum", "average", "sum", "sample_count"}, []string{"mean()", "max()", "min()", "sum()", "count()"}},
+		{[]string{"id", "p95"}, []string{"percentile(0.95)"}},
+		{[]string{"p50", "p90", "p99", "rate", "unit"}, []string{"percentile(0.5)", "percentile(0.9)", "percentile(0.99)", "rate()"}},
+	}
+
+	for _, test := range tests {
+		d := &plugin.QueryData{QueryContext: &plugin.QueryContext{Columns: test.columns}}
+		functions := []string{}
+		for _, statistic := range getMonitoringStatistics(d) {
+			functions = append(functions, statistic.Function)
+		}
+		if strings.Join(functions, " ") != strings.Join(test.want, " ") {
+			t.Errorf("getMonitoringStatistics() of %v = %v, want %v", test.columns, functions, test.want)
+		}
+	}
+}
@@ -2,7 +2,10 @@ package oci
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"path"
+	"regexp"
 	"strings"
 
 	"github.com/oracle/oci-go-sdk/v44/cloudguard"
@@ -17,185 +20,363 @@ const matrixKeyRegion = "region"
 const matrixKeyCompartment = "compartment"
 const matrixKeyZone = "zone"
 
-// var pluginQueryData *plugin.QueryData
+// matrix keys which are filtered by the quals of the column with the given name
+var matrixQualColumns = map[string]string{
+	"compartment_id": matrixKeyCompartment,
+	"region":         matrixKeyRegion,
+}
+
+// failMatrix aborts the query with the given error. Matrix functions cannot return
+// an error, but the plugin SDK recovers the panic and returns the error as the
+// result of the query.
+func failMatrix(err error) {
+	panic(err)
+}
+
+// filterMatrixItems returns the matrix items which can match the equality and
+// IN quals of the `compartment_id` and `region` key columns, so the list call
+// is not run for every compartment and region of the cached matrix. Only key
+// column quals are used, as they are part of the query cache key. Quals on a
+// `region` column which is not a key column are applied by the plugin SDK, as
+// the column matches the matrix key.
+func filterMatrixItems(d *plugin.QueryData, matrix []map[string]interface{}) []map[string]interface{} {
+	allowed := map[string]map[string]bool{}
+	for column, matrixKey := range matrixQualColumns {
+		keyColumnQuals, ok := d.Quals[column]
+		if !ok {
+			continue
+		}
+		for _, qual := range keyColumnQuals.Quals {
+			if qual.Operator != "=" {
+				continue
+			}
+			values := map[string]bool{}
+			if listValue := qual.Value.GetListValue(); listValue != nil {
+				for _, value := range listValue.Values {
+					values[value.GetStringValue()] = true
+				}
+			} else {
+				values[qual.Value.GetStringValue()] = true
+			}
+			// quals on the same column must all match
+			if previous, ok := allowed[matrixKey]; ok {
+				for value := range values {
+					values[value] = previous[value]
+				}
+			}
+			allowed[matrixKey] = values
+		}
+	}
+	if len(allowed) == 0 {
+		return matrix
+	}
 
-// func init() {
-// 	pluginQueryData = &plugin.QueryData{
-// 		ConnectionManager: connection.NewManager(),
-// 	}
-// }
+	filtered := []map[string]interface{}{}
+	for _, item := range matrix {
+		include := true
+		for matrixKey, values := range allowed {
+			if value, ok := item[matrixKey].(string); ok && !values[value] {
+				include = false
+				break
+			}
+		}
+		if include {
+			filtered = append(filtered, item)
+		}
+	}
 
-// BuildRegionList :: return a list of matrix items, one per region specified in the connection config
-func BuildRegionList(_ context.Context, d *plugin.QueryData) []map[string]interface{} {
-	// retrieve regions from connection config
-	ociConfig := GetConfig(d.Connection)
+	// the plugin SDK runs the list call without a matrix item for an empty
+	// matrix, so a single item is kept. Its rows are filtered out by the quals.
+	if len(filtered) == 0 && len(matrix) > 0 {
+		return matrix[:1]
+	}
+	return filtered
+}
+
+// BuildRegionList :: return a list of matrix items, one per tenancy-region specified in the connection config
+func BuildRegionList(ctx context.Context, d *plugin.QueryData) []map[string]interface{} {
+	matrix, err := buildRegionList(ctx, d)
+	if err != nil {
+		failMatrix(err)
+	}
+	return filterMatrixItems(d, matrix)
+}
 
-	if ociConfig.Regions != nil {
-		regions := GetConfig(d.Connection).Regions
+func buildRegionList(ctx context.Context, d *plugin.QueryData) ([]map[string]interface{}, error) {
+	tenancies, err := getTenancies(d.Connection)
+	if err != nil {
+		return nil, fmt.Errorf("%s. Edit your connection configuration file and then restart Steampipe", err.Error())
+	}
 
-		if len(getInvalidRegions(regions)) > 0 {
-			panic("\n\nConnection config have invalid regions: " + strings.Join(getInvalidRegions(regions), ","))
+	builder := newMatrixBuilder("BuildRegionList")
+	for _, tenancy := range tenancies {
+		regions, err := getTenancyRegions(ctx, d, tenancy)
+		if err != nil {
+			if err := builder.skip(ctx, tenancy.Name, "", err); err != nil {
+				return nil, err
+			}
+			continue
 		}
 
-		// validate regions list
-		matrix := make([]map[string]interface{}, len(regions))
-		for i, region := range regions {
-			matrix[i] = map[string]interface{}{matrixKeyRegion: region}
+		for _, region := range regions {
+			builder.add(map[string]interface{}{
+				matrixKeyTenancy: tenancy.Name,
+				matrixKeyRegion:  region,
+			})
 		}
-		return matrix
 	}
 
-	return []map[string]interface{}{
-		{matrixKeyRegion: getRegionFromEnvVar()},
-	}
+	return builder.build(d, "RegionList")
 }
 
-// BuildCompartmentList :: return a list of matrix items, one per compartment specified in the connection config
+// BuildCompartmentList :: return a list of matrix items, one per tenancy-compartment specified in the connection config
 func BuildCompartmentList(ctx context.Context, d *plugin.QueryData) []map[string]interface{} {
+	matrix, err := buildCompartmentList(ctx, d)
+	if err != nil {
+		failMatrix(err)
+	}
+	return filterMatrixItems(d, matrix)
+}
+
+func buildCompartmentList(ctx context.Context, d *plugin.QueryData) ([]map[string]interface{}, error) {
 	// cache compartment matrix
 	cacheKey := "CompartmentList"
 
 	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
-		return cachedData.([]map[string]interface{})
+		return cachedData.([]map[string]interface{}), nil
 	}
 
-	// get all the compartments in the tenant
-	compartments, err := listAllCompartments(ctx, d)
+	tenancies, err := getTenancies(d.Connection)
 	if err != nil {
-		if strings.Contains(err.Error(), "proper configuration for region") || strings.Contains(err.Error(), "OCI_REGION") {
-			panic("\n\n'regions' must be set in the connection configuration. Edit your connection configuration file and then restart Steampipe")
-		}
-		panic(err)
+		return nil, fmt.Errorf("%s. Edit your connection configuration file and then restart Steampipe", err.Error())
 	}
 
-	// validate compartment list
-	matrix := make([]map[string]interface{}, len(compartments))
-	for i, compartment := range compartments {
-		matrix[i] = map[string]interface{}{matrixKeyCompartment: *compartment.Id}
+	builder := newMatrixBuilder("BuildCompartmentList")
+	for _, tenancy := range tenancies {
+		// get all the compartments in the tenant
+		compartments, err := listMatrixCompartments(withTenancy(ctx, tenancy.Name), d)
+		if err != nil {
+			if err := builder.skip(ctx, tenancy.Name, "", err); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		for _, compartment := range compartments {
+			builder.add(map[string]interface{}{
+				matrixKeyTenancy:     tenancy.Name,
+				matrixKeyCompartment: *compartment.Id,
+			})
+		}
 	}
-	// set CompartmentList cache
-	d.ConnectionManager.Cache.Set(cacheKey, matrix)
 
-	return matrix
+	// set CompartmentList cache
+	return builder.build(d, cacheKey)
 }
 
-// BuildCompartmentRegionList :: return a list of matrix items, one per region-compartment specified in the connection config
+// BuildCompartmentRegionList :: return a list of matrix items, one per tenancy-region-compartment specified in the connection config
 func BuildCompartementRegionList(ctx context.Context, d *plugin.QueryData) []map[string]interface{} {
+	matrix, err := buildCompartmentRegionList(ctx, d)
+	if err != nil {
+		failMatrix(err)
+	}
+	return filterMatrixItems(d, matrix)
+}
 
+func buildCompartmentRegionList(ctx context.Context, d *plugin.QueryData) ([]map[string]interface{}, error) {
 	// cache compartment region matrix
 	cacheKey := "CompartmentRegionList"
 
 	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
-		return cachedData.([]map[string]interface{})
+		return cachedData.([]map[string]interface{}), nil
 	}
 
-	// get all the compartments in the tenant
-	compartments, err := listAllCompartments(ctx, d)
+	tenancies, err := getTenancies(d.Connection)
 	if err != nil {
-		if strings.Contains(err.Error(), "proper configuration for region") || strings.Contains(err.Error(), "OCI_REGION") {
-			panic("\n\n'regions' must be set in the connection configuration. Edit your connection configuration file and then restart Steampipe")
-		}
-		panic(err)
+		return nil, fmt.Errorf("%s. Edit your connection configuration file and then restart Steampipe", err.Error())
 	}
 
-	// retrieve regions from connection config
-	ociConfig := GetConfig(d.Connection)
-
-	if ociConfig.Regions != nil {
-		regions := GetConfig(d.Connection).Regions
+	builder := newMatrixBuilder("BuildCompartementRegionList")
+	for _, tenancy := range tenancies {
+		// get all the compartments in the tenant
+		compartments, err := listMatrixCompartments(withTenancy(ctx, tenancy.Name), d)
+		if err != nil {
+			if err := builder.skip(ctx, tenancy.Name, "", err); err != nil {
+				return nil, err
+			}
+			continue
+		}
 
-		if len(getInvalidRegions(regions)) > 0 {
-			panic("\n\nConnection config have invalid regions: " + strings.Join(getInvalidRegions(regions), ",") + ". Edit your connection configuration file and then restart Steampipe")
+		regions, err := getTenancyRegions(ctx, d, tenancy)
+		if err != nil {
+			if err := builder.skip(ctx, tenancy.Name, "", err); err != nil {
+				return nil, err
+			}
+			continue
 		}
 
-		// validate regions list
-		matrix := make([]map[string]interface{}, len(regions)*len(compartments))
-		for i, region := range regions {
-			for j, compartment := range compartments {
-				matrix[len(compartments)*i+j] = map[string]interface{}{
+		for _, region := range regions {
+			for _, compartment := range compartments {
+				item := map[string]interface{}{
+					matrixKeyTenancy:     tenancy.Name,
 					matrixKeyRegion:      region,
 					matrixKeyCompartment: *compartment.Id,
 				}
-				plugin.Logger(ctx).Debug("listAllCompartments Matrix", (len(compartments)*i)+j, matrix[len(compartments)*i+j])
+				plugin.Logger(ctx).Debug("listAllCompartments Matrix", len(builder.items), item)
+				builder.add(item)
 			}
 		}
+	}
 
-		// set CompartmentRegionList cache
-		d.ConnectionManager.Cache.Set(cacheKey, matrix)
-		return matrix
+	// set CompartmentRegionList cache
+	return builder.build(d, cacheKey)
+}
+
+// getTenancyRegions returns the regions to query in the tenancy, or the region from the environment if none are configured.
+// Wildcard patterns such as "*" or "eu-*" are resolved against the region subscriptions of the tenancy.
+func getTenancyRegions(ctx context.Context, d *plugin.QueryData, tenancy tenancyConfig) ([]string, error) {
+	if tenancy.Regions == nil {
+		return []string{getRegionFromEnvVar()}, nil
 	}
 
-	defaultMatrix := make([]map[string]interface{}, len(compartments))
-	for j, compartment := range compartments {
-		defaultMatrix[j] = map[string]interface{}{
-			matrixKeyRegion:      getRegionFromEnvVar(),
-			matrixKeyCompartment: *compartment.Id,
+	if invalidRegions := getInvalidRegions(tenancy.Regions); len(invalidRegions) > 0 {
+		return nil, fmt.Errorf("connection config have invalid regions: %s. Edit your connection configuration file and then restart Steampipe", strings.Join(invalidRegions, ","))
+	}
+
+	hasPattern := false
+	for _, region := range tenancy.Regions {
+		hasPattern = hasPattern || isRegionPattern(region)
+	}
+	if !hasPattern {
+		return tenancy.Regions, nil
+	}
+
+	subscribedRegions, err := listSubscribedRegions(withTenancy(ctx, tenancy.Name), d)
+	if err != nil {
+		return nil, err
+	}
+
+	regions := []string{}
+	for _, pattern := range tenancy.Regions {
+		// regions without a wildcard are always queried, even if the tenancy is not subscribed to them
+		if !isRegionPattern(pattern) {
+			if !helpers.StringSliceContains(regions, pattern) {
+				regions = append(regions, pattern)
+			}
+			continue
+		}
+
+		matched := false
+		for _, region := range subscribedRegions {
+			if ok, _ := path.Match(pattern, region); ok {
+				matched = true
+				if !helpers.StringSliceContains(regions, region) {
+					regions = append(regions, region)
+				}
+			}
+		}
+		if !matched {
+			plugin.Logger(ctx).Warn("getTenancyRegions", "tenancy", tenancy.Name, "pattern", pattern, "warning", "pattern does not match any subscribed region")
 		}
-		plugin.Logger(ctx).Debug("listAllCompartments MATRIX", j, defaultMatrix[j])
 	}
 
-	// set CompartmentRegionList cache
-	d.ConnectionManager.Cache.Set(cacheKey, defaultMatrix)
+	if len(regions) == 0 {
+		return nil, fmt.Errorf("connection config regions %s do not match any region the tenancy is subscribed to", strings.Join(tenancy.Regions, ","))
+	}
 
-	return defaultMatrix
+	return regions, nil
 }
 
-func getInvalidRegions(regions []string) []string {
-	ociRegions := []string{
-		"ap-chiyoda-1",
-		"ap-chuncheon-1",
-		"ap-hyderabad-1",
-		"ap-melbourne-1",
-		"ap-mumbai-1",
-		"ap-osaka-1",
-		"ap-seoul-1",
-		"ap-sydney-1",
-		"ap-tokyo-1",
-		"ca-montreal-1",
-		"ca-toronto-1",
-		"eu-amsterdam-1",
-		"eu-frankfurt-1",
-		"eu-zurich-1",
-		"me-dubai-1",
-		"me-jeddah-1",
-		"sa-santiago-1",
-		"sa-saopaulo-1",
-		"sa-vinhedo-1",
-		"uk-cardiff-1",
-		"uk-gov-cardiff-1",
-		"uk-gov-london-1",
-		"uk-london-1",
-		"us-ashburn-1",
-		"us-gov-ashburn-1",
-		"us-gov-chicago-1",
-		"us-gov-phoenix-1",
-		"us-langley-1",
-		"us-luke-1",
-		"us-phoenix-1",
-		"us-sanjose-1",
-	}
+var regionNameRegex = regexp.MustCompile(`^[a-z]+(-[a-z]+)+-[0-9]+$`)
+
+// isRegionPattern returns true if the configured region is a wildcard pattern
+func isRegionPattern(region string) bool {
+	return strings.ContainsAny(region, "*?[")
+}
 
+// getInvalidRegions returns the configured regions which are neither a well formed region name nor a valid wildcard pattern
+func getInvalidRegions(regions []string) []string {
 	invalidRegions := []string{}
 	for _, region := range regions {
-		if !helpers.StringSliceContains(ociRegions, region) {
+		if isRegionPattern(region) {
+			if _, err := path.Match(region, ""); err != nil {
+				invalidRegions = append(invalidRegions, region)
+			}
+			continue
+		}
+		if !regionNameRegex.MatchString(region) {
 			invalidRegions = append(invalidRegions, region)
 		}
 	}
 	return invalidRegions
 }
 
-func listAllCompartments(ctx context.Context, d *plugin.QueryData) ([]identity.Compartment, error) {
+// listMatrixCompartments returns the compartments of the tenancy which are in
+// scope of the `include_compartments` and `exclude_compartments` settings
+func listMatrixCompartments(ctx context.Context, d *plugin.QueryData) ([]identity.Compartment, error) {
+	compartments, err := listAllCompartments(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+	return filterCompartments(d.Connection, compartments)
+}
+
+// listSubscribedRegions returns the names of the regions the tenancy is subscribed to
+func listSubscribedRegions(ctx context.Context, d *plugin.QueryData) ([]string, error) {
+	cacheKey := fmt.Sprintf("listSubscribedRegions-%s", getTenancyName(ctx))
+	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+		return cachedData.([]string), nil
+	}
+
 	// Create Session
 	session, err := identityService(ctx, d)
 	if err != nil {
 		return nil, err
 	}
 
-	serviceCacheKey := "listAllCompartments"
+	request := identity.ListRegionSubscriptionsRequest{
+		TenancyId: &session.TenancyID,
+		RequestMetadata: oci_common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	response, err := session.IdentityClient.ListRegionSubscriptions(ctx, request)
+	if err != nil {
+		return nil, wrapServiceError(err, session, "ListRegionSubscriptions")
+	}
+
+	regions := []string{}
+	for _, subscription := range response.Items {
+		if subscription.Status == identity.RegionSubscriptionStatusReady {
+			regions = append(regions, *subscription.RegionName)
+		}
+	}
+
+	// save subscribed regions in cache
+	d.ConnectionManager.Cache.Set(cacheKey, regions)
+
+	return regions, nil
+}
+
+func listAllCompartments(ctx context.Context, d *plugin.QueryData) ([]identity.Compartment, error) {
+	serviceCacheKey := fmt.Sprintf("listAllCompartments-%s", getTenancyName(ctx))
 	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
 		return cachedData.([]identity.Compartment), nil
 	}
 
+	// a restarted plugin reads the compartment tree from the disk cache
+	if compartments, ok := getDiskCache[[]identity.Compartment](ctx, d, diskCacheCompartments, ""); ok {
+		d.ConnectionManager.Cache.Set(serviceCacheKey, compartments)
+		return compartments, nil
+	}
+
+	// Create Session
+	session, err := identityService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
 	// Add root tenant by default
 	compartments := []identity.Compartment{
 		{
@@ -216,7 +397,7 @@ func listAllCompartments(ctx context.Context, d *plugin.QueryData) ([]identity.C
 	for pagesLeft {
 		response, err := session.IdentityClient.ListCompartments(ctx, request)
 		if err != nil {
-			return nil, err
+			return nil, wrapServiceError(err, session, "ListCompartments")
 		}
 
 		for _, compartment := range response.Items {
@@ -234,6 +415,7 @@ func listAllCompartments(ctx context.Context, d *plugin.QueryData) ([]identity.C
 
 	// save compartments in cache
 	d.ConnectionManager.Cache.Set(serviceCacheKey, compartments)
+	setDiskCache(ctx, d, diskCacheCompartments, "", compartments)
 
 	return compartments, err
 }
@@ -243,39 +425,18 @@ type zoneInfo struct {
 	Region string
 }
 
-func listAllzones(ctx context.Context, d *plugin.QueryData) ([]zoneInfo, error) {
-
-	zonesList := []zoneInfo{}
-
-	regions := GetConfig(d.Connection).Regions
-
-	if regions != nil {
-		for _, region := range regions {
-			session, err := identityServiceRegional(ctx, d, region)
-			if err != nil {
-				return nil, err
-			}
-
-			// The OCID of the tenancy containing the compartment.
-			request := identity.ListAvailabilityDomainsRequest{
-				CompartmentId: &session.TenancyID,
-				RequestMetadata: oci_common.RequestMetadata{
-					RetryPolicy: getDefaultRetryPolicy(d.Connection),
-				},
-			}
-
-			response, err := session.IdentityClient.ListAvailabilityDomains(ctx, request)
-			if err != nil {
-				return nil, err
-			}
+// listRegionZones returns the availability domains of the tenancy in the given region
+func listRegionZones(ctx context.Context, d *plugin.QueryData, region string) ([]zoneInfo, error) {
+	cacheKey := fmt.Sprintf("listRegionZones-%s-%s", getTenancyName(ctx), region)
+	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+		return cachedData.([]zoneInfo), nil
+	}
 
-			for _, zones := range response.Items {
-				zonesList = append(zonesList, zoneInfo{zones, region})
-			}
-		}
-		return zonesList, nil
+	if zones, ok := getDiskCache[[]zoneInfo](ctx, d, diskCacheZones, region); ok {
+		d.ConnectionManager.Cache.Set(cacheKey, zones)
+		return zones, nil
 	}
-	region := getRegionFromEnvVar()
+
 	session, err := identityServiceRegional(ctx, d, region)
 	if err != nil {
 		return nil, err
@@ -291,57 +452,89 @@ func listAllzones(ctx context.Context, d *plugin.QueryData) ([]zoneInfo, error)
 
 	response, err := session.IdentityClient.ListAvailabilityDomains(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "ListAvailabilityDomains")
 	}
 
+	zonesList := []zoneInfo{}
 	for _, zones := range response.Items {
 		zonesList = append(zonesList, zoneInfo{zones, region})
 	}
+
+	// save zones in cache
+	d.ConnectionManager.Cache.Set(cacheKey, zonesList)
+	setDiskCache(ctx, d, diskCacheZones, region, zonesList)
+
 	return zonesList, nil
 }
 
-// BuildCompartmentZonalList :: return a list of matrix items, one per zone-compartment specified in the connection config
+// BuildCompartmentZonalList :: return a list of matrix items, one per tenancy-zone-compartment specified in the connection config
 func BuildCompartementZonalList(ctx context.Context, d *plugin.QueryData) []map[string]interface{} {
+	matrix, err := buildCompartmentZonalList(ctx, d)
+	if err != nil {
+		failMatrix(err)
+	}
+	return filterMatrixItems(d, matrix)
+}
+
+func buildCompartmentZonalList(ctx context.Context, d *plugin.QueryData) ([]map[string]interface{}, error) {
 	cacheKey := "CompartmentZonalList"
 	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
-		return cachedData.([]map[string]interface{})
+		return cachedData.([]map[string]interface{}), nil
 	}
 
-	compartments, err := listAllCompartments(ctx, d)
+	tenancies, err := getTenancies(d.Connection)
 	if err != nil {
-		if strings.Contains(err.Error(), "proper configuration for region") || strings.Contains(err.Error(), "OCI_REGION") {
-			panic("\n\n'regions' must be set in the connection configuration. Edit your connection configuration file and then restart Steampipe")
-		}
-		panic(err)
+		return nil, fmt.Errorf("%s. Edit your connection configuration file and then restart Steampipe", err.Error())
 	}
 
-	plugin.Logger(ctx).Debug("compartments", "compartments", compartments)
+	builder := newMatrixBuilder("BuildCompartementZonalList")
+	for _, tenancy := range tenancies {
+		tenancyCtx := withTenancy(ctx, tenancy.Name)
 
-	zones, err := listAllzones(ctx, d)
-	if err != nil {
-		if strings.Contains(err.Error(), "proper configuration for region") || strings.Contains(err.Error(), "OCI_REGION") {
-			panic("\n\n'regions' must be set in the connection configuration. Edit your connection configuration file and then restart Steampipe")
+		compartments, err := listMatrixCompartments(tenancyCtx, d)
+		if err != nil {
+			if err := builder.skip(ctx, tenancy.Name, "", err); err != nil {
+				return nil, err
+			}
+			continue
 		}
-		panic(err)
-	}
 
-	matrix := make([]map[string]interface{}, len(zones)*len(compartments))
+		plugin.Logger(ctx).Debug("compartments", "tenancy", tenancy.Name, "compartments", compartments)
 
-	for i, zone := range zones {
-		for j, compartment := range compartments {
-			matrix[len(compartments)*i+j] = map[string]interface{}{
-				matrixKeyZone:        *zone.Name,
-				matrixKeyCompartment: *compartment.Id,
-				matrixKeyRegion:      zone.Region,
+		regions, err := getTenancyRegions(ctx, d, tenancy)
+		if err != nil {
+			if err := builder.skip(ctx, tenancy.Name, "", err); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		for _, region := range regions {
+			zones, err := listRegionZones(tenancyCtx, d, region)
+			if err != nil {
+				if err := builder.skip(ctx, tenancy.Name, region, err); err != nil {
+					return nil, err
+				}
+				continue
+			}
+
+			for _, zone := range zones {
+				for _, compartment := range compartments {
+					item := map[string]interface{}{
+						matrixKeyTenancy:     tenancy.Name,
+						matrixKeyZone:        *zone.Name,
+						matrixKeyCompartment: *compartment.Id,
+						matrixKeyRegion:      zone.Region,
+					}
+					plugin.Logger(ctx).Debug("listAllCompartments Matrix", len(builder.items), item)
+					builder.add(item)
+				}
 			}
-			plugin.Logger(ctx).Debug("listAllCompartments Matrix", (len(compartments)*i)+j, matrix[len(compartments)*i+j])
 		}
 	}
 
 	// set CompartmentZonalList cache
-	d.ConnectionManager.Cache.Set(cacheKey, matrix)
-
-	return matrix
+	return builder.build(d, cacheKey)
 }
 
 // func getRegionFromEnvVar() (string, error) {
@@ -356,11 +549,16 @@ func getRegionFromEnvVar() string {
 }
 
 func getCloudGuardConfiguration(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	cacheKey := "getCloudGuardConfiguration"
+	cacheKey := fmt.Sprintf("getCloudGuardConfiguration-%s", getTenancyName(ctx))
 	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
 		return cachedData.(cloudguard.Configuration), nil
 	}
 
+	if configuration, ok := getDiskCache[cloudguard.Configuration](ctx, d, diskCacheCloudGuardConfiguration, ""); ok {
+		d.ConnectionManager.Cache.Set(cacheKey, configuration)
+		return configuration, nil
+	}
+
 	// Create Session
 	session, err := cloudGuardService(ctx, d, "")
 	if err != nil {
@@ -376,11 +574,12 @@ func getCloudGuardConfiguration(ctx context.Context, d *plugin.QueryData, _ *plu
 
 	response, err := session.CloudGuardClient.GetConfiguration(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetConfiguration")
 	}
 
 	// set response cache
 	d.ConnectionManager.Cache.Set(cacheKey, response.Configuration)
+	setDiskCache(ctx, d, diskCacheCloudGuardConfiguration, "", response.Configuration)
 
 	return response.Configuration, nil
 }
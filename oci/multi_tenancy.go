@@ -0,0 +1,203 @@
+package oci
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+)
+
+const matrixKeyTenancy = "tenancy"
+
+type tenancyContextKey struct{}
+
+// tenancyConfig holds the connection settings of a single entry in the `tenancies` list
+type tenancyConfig struct {
+	Name       string
+	Profile    *string
+	ConfigPath *string
+	Auth       *string
+	Regions    []string
+}
+
+/*
+Each entry of the `tenancies` list is either a profile name from the OCI config
+file, or a list of `key=value` pairs separated by semicolons:
+
+	tenancies = [
+		"tenant_a",
+		"name=tenant_b;profile=tenant_b;auth=SecurityToken;regions=us-ashburn-1,eu-frankfurt-1",
+	]
+
+Supported keys are `name`, `profile`, `config_path`, `auth` and `regions`. The
+name defaults to the profile name and must be unique within the connection.
+*/
+func parseTenancy(spec string) (tenancyConfig, error) {
+	spec = strings.TrimSpace(spec)
+	if spec == "" {
+		return tenancyConfig{}, fmt.Errorf("tenancy entry must not be empty")
+	}
+
+	// a bare entry is shorthand for a profile name
+	if !strings.Contains(spec, "=") {
+		return tenancyConfig{Name: spec, Profile: types.String(spec)}, nil
+	}
+
+	tenancy := tenancyConfig{}
+	for _, part := range strings.Split(spec, ";") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		kv := strings.SplitN(part, "=", 2)
+		if len(kv) != 2 {
+			return tenancyConfig{}, fmt.Errorf("invalid tenancy setting '%s' in '%s', expected key=value", part, spec)
+		}
+		key, value := strings.TrimSpace(kv[0]), strings.TrimSpace(kv[1])
+		switch key {
+		case "name":
+			tenancy.Name = value
+		case "profile":
+			tenancy.Profile = types.String(value)
+		case "config_path":
+			tenancy.ConfigPath = types.String(value)
+		case "auth":
+			tenancy.Auth = types.String(value)
+		case "regions":
+			for _, region := range strings.Split(value, ",") {
+				if region = strings.TrimSpace(region); region != "" {
+					tenancy.Regions = append(tenancy.Regions, region)
+				}
+			}
+		default:
+			return tenancyConfig{}, fmt.Errorf("unknown tenancy setting '%s' in '%s'", key, spec)
+		}
+	}
+
+	if tenancy.Name == "" && tenancy.Profile != nil {
+		tenancy.Name = *tenancy.Profile
+	}
+	if tenancy.Name == "" {
+		return tenancyConfig{}, fmt.Errorf("tenancy entry '%s' must set either 'name' or 'profile'", spec)
+	}
+
+	return tenancy, nil
+}
+
+// getTenancies returns the tenancies configured for the connection.
+// A connection without a `tenancies` list has a single, unnamed tenancy built
+// from the top level connection settings.
+func getTenancies(connection *plugin.Connection) ([]tenancyConfig, error) {
+	config := GetConfig(connection)
+	if len(config.Tenancies) == 0 {
+		return []tenancyConfig{{Regions: config.Regions}}, nil
+	}
+
+	tenancies := make([]tenancyConfig, 0, len(config.Tenancies))
+	names := map[string]bool{}
+	for _, spec := range config.Tenancies {
+		tenancy, err := parseTenancy(spec)
+		if err != nil {
+			return nil, err
+		}
+		if names[tenancy.Name] {
+			return nil, fmt.Errorf("tenancy '%s' is configured more than once", tenancy.Name)
+		}
+		names[tenancy.Name] = true
+
+		// fall back to the connection level regions
+		if tenancy.Regions == nil {
+			tenancy.Regions = config.Regions
+		}
+		tenancies = append(tenancies, tenancy)
+	}
+
+	return tenancies, nil
+}
+
+// getTenancy returns the tenancy with the given name, or the first configured
+// tenancy if the name is empty
+func getTenancy(connection *plugin.Connection, name string) (tenancyConfig, error) {
+	tenancies, err := getTenancies(connection)
+	if err != nil {
+		return tenancyConfig{}, err
+	}
+	if name == "" {
+		return tenancies[0], nil
+	}
+	for _, tenancy := range tenancies {
+		if tenancy.Name == name {
+			return tenancy, nil
+		}
+	}
+	return tenancyConfig{}, fmt.Errorf("tenancy '%s' is not configured in the connection", name)
+}
+
+// forTenancy returns a copy of the connection config with the tenancy specific settings applied
+func (c ociConfig) forTenancy(tenancy tenancyConfig) ociConfig {
+	if tenancy.Profile != nil {
+		c.Profile = tenancy.Profile
+	}
+	if tenancy.ConfigPath != nil {
+		c.ConfigPath = tenancy.ConfigPath
+	}
+	if tenancy.Auth != nil {
+		c.Auth = tenancy.Auth
+	}
+	if tenancy.Regions != nil {
+		c.Regions = tenancy.Regions
+	}
+	return c
+}
+
+// getTenancyConfig returns the connection config for the tenancy of the current matrix item
+func getTenancyConfig(ctx context.Context, d *plugin.QueryData) (ociConfig, error) {
+	tenancy, err := getTenancy(d.Connection, getTenancyName(ctx))
+	if err != nil {
+		return ociConfig{}, err
+	}
+	return GetConfig(d.Connection).forTenancy(tenancy), nil
+}
+
+// withTenancy returns a context scoped to the given tenancy, used when no matrix item is available yet
+func withTenancy(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, tenancyContextKey{}, name)
+}
+
+// getTenancyName returns the name of the tenancy the current call is scoped to.
+// An empty name refers to the default (first) tenancy of the connection.
+func getTenancyName(ctx context.Context) string {
+	if name, ok := ctx.Value(tenancyContextKey{}).(string); ok {
+		return name
+	}
+	if matrixItem := plugin.GetMatrixItem(ctx); matrixItem != nil {
+		if name, ok := matrixItem[matrixKeyTenancy].(string); ok {
+			return name
+		}
+	}
+	return ""
+}
+
+// tenancyCacheKey returns a cache key function for plugin.HydrateFunc.WithCache
+// so that cached hydrate results are not shared between tenancies
+func tenancyCacheKey(name string) plugin.HydrateFunc {
+	return func(ctx context.Context, _ *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+		return fmt.Sprintf("%s-%s", name, getTenancyName(ctx)), nil
+	}
+}
+
+// BuildTenancyList :: return a list of matrix items, one per tenancy specified in the connection config
+func BuildTenancyList(_ context.Context, d *plugin.QueryData) []map[string]interface{} {
+	tenancies, err := getTenancies(d.Connection)
+	if err != nil {
+		failMatrix(fmt.Errorf("%s. Edit your connection configuration file and then restart Steampipe", err.Error()))
+	}
+
+	matrix := make([]map[string]interface{}, len(tenancies))
+	for i, tenancy := range tenancies {
+		matrix[i] = map[string]interface{}{matrixKeyTenancy: tenancy.Name}
+	}
+	return matrix
+}
@@ -1,19 +1,48 @@
 package oci
 
 import (
+	"context"
 	"strconv"
+	"strings"
 
 	oci_common "github.com/oracle/oci-go-sdk/v44/common"
 	"github.com/turbot/go-kit/helpers"
 	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
 )
 
-// function which returns an ErrorPredicate for OCI API calls
-func isNotFoundError(notFoundErrors []string) plugin.ErrorPredicate {
-	return func(err error) bool {
-		if ociErr, ok := err.(oci_common.ServiceError); ok {
-			return helpers.StringSliceContains(notFoundErrors, strconv.Itoa(ociErr.GetHTTPStatusCode()))
+// function which returns an ErrorPredicateWithContext for OCI API calls, which
+// ignores the given HTTP statuses as well as the `ignore_error_codes` of the connection
+func isNotFoundError(notFoundErrors []string) plugin.ErrorPredicateWithContext {
+	return func(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData, err error) bool {
+		ociErr, ok := getServiceError(err)
+		if !ok {
+			return false
 		}
+		if helpers.StringSliceContains(notFoundErrors, strconv.Itoa(ociErr.GetHTTPStatusCode())) {
+			return true
+		}
+		return isIgnoredErrorCode(GetConfig(d.Connection).IgnoreErrorCodes, ociErr)
+	}
+}
+
+// shouldIgnoreErrorFromConfig ignores OCI API errors matching the `ignore_error_codes` of the connection
+func shouldIgnoreErrorFromConfig(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData, err error) bool {
+	ociErr, ok := getServiceError(err)
+	if !ok {
 		return false
 	}
+	return isIgnoredErrorCode(GetConfig(d.Connection).IgnoreErrorCodes, ociErr)
+}
+
+// isIgnoredErrorCode returns true if the HTTP status or the service error code, e.g.
+// "NotAuthorizedOrNotFound", of an OCI API error is one of the given codes
+func isIgnoredErrorCode(ignoreErrorCodes []string, ociErr oci_common.ServiceError) bool {
+	statusCode := strconv.Itoa(ociErr.GetHTTPStatusCode())
+	for _, code := range ignoreErrorCodes {
+		code = strings.TrimSpace(code)
+		if code == statusCode || strings.EqualFold(code, ociErr.GetCode()) {
+			return true
+		}
+	}
+	return false
 }
@@ -0,0 +1,135 @@
+package ocitest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+/*
+Fixture is a recorded response of the fake OCI API server. A request is served
+by the first fixture of its service with the same method, a matching path and
+all of the fixture query parameters and body strings:
+
+	{
+		"service": "virtual_network",
+		"path": "/20160918/vcns",
+		"query": {"compartmentId": "ocid1.tenancy.oc1..aaaaaaaafake"},
+		"pages": [
+			[{"id": "ocid1.vcn.oc1.iad.aaaaaaaa1", "displayName": "vcn-1"}],
+			[{"id": "ocid1.vcn.oc1.iad.aaaaaaaa2", "displayName": "vcn-2"}]
+		]
+	}
+
+A `*` element of the path matches any single element, e.g. "/20160918/vcns/*".
+List responses with more than one page return the index of the next page in
+the opc-next-page header. Error responses set `status`, `code` and `message`.
+*/
+type Fixture struct {
+	// service name used by the plugin, e.g. "virtual_network"
+	Service string `json:"service"`
+	// HTTP method, defaults to GET
+	Method string `json:"method,omitempty"`
+	// request path, including the API version
+	Path string `json:"path"`
+	// query parameters the request must have
+	Query map[string]string `json:"query,omitempty"`
+	// strings the request body must contain, e.g. the MQL function of a metric query
+	BodyContains []string `json:"bodyContains,omitempty"`
+	// HTTP status, defaults to 200
+	Status int `json:"status,omitempty"`
+	// service error code and message of an error response
+	Code    string `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+	// response body of a single response
+	Body json.RawMessage `json:"body,omitempty"`
+	// response bodies of a paginated list response
+	Pages []json.RawMessage `json:"pages,omitempty"`
+	// additional response headers, e.g. etag
+	Headers map[string]string `json:"headers,omitempty"`
+}
+
+// LoadFixtures reads the fixtures of all .json files of a directory, each
+// holding a single fixture or a list of fixtures
+func LoadFixtures(dir string) ([]Fixture, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	fixtures := []Fixture{}
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		var list []Fixture
+		if strings.HasPrefix(strings.TrimSpace(string(data)), "[") {
+			err = json.Unmarshal(data, &list)
+		} else {
+			var fixture Fixture
+			err = json.Unmarshal(data, &fixture)
+			list = []Fixture{fixture}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid fixture file %s: %v", path, err)
+		}
+
+		for _, fixture := range list {
+			if fixture.Service == "" || fixture.Path == "" {
+				return nil, fmt.Errorf("invalid fixture file %s: fixtures require a service and a path", path)
+			}
+			fixtures = append(fixtures, fixture)
+		}
+	}
+	return fixtures, nil
+}
+
+// matches returns true if the fixture serves a request with a body
+func (f Fixture) matches(req *http.Request, body string) bool {
+	method := f.Method
+	if method == "" {
+		method = http.MethodGet
+	}
+	if !strings.EqualFold(method, req.Method) || !matchPath(f.Path, req.URL.Path) {
+		return false
+	}
+	for _, value := range f.BodyContains {
+		if !strings.Contains(body, value) {
+			return false
+		}
+	}
+	return matchQuery(f.Query, req.URL.Query())
+}
+
+func matchPath(pattern string, path string) bool {
+	patternElements := strings.Split(strings.Trim(pattern, "/"), "/")
+	pathElements := strings.Split(strings.Trim(path, "/"), "/")
+	if len(patternElements) != len(pathElements) {
+		return false
+	}
+	for i, element := range patternElements {
+		if element == "*" {
+			continue
+		}
+		// path elements are compared unescaped, e.g. object names with spaces
+		if unescaped, err := url.PathUnescape(pathElements[i]); err != nil || unescaped != element {
+			return false
+		}
+	}
+	return true
+}
+
+func matchQuery(query map[string]string, values url.Values) bool {
+	for key, value := range query {
+		if values.Get(key) != value {
+			return false
+		}
+	}
+	return true
+}
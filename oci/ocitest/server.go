@@ -0,0 +1,236 @@
+/*
+Package ocitest implements an in-process fake OCI API server, which serves
+recorded JSON fixtures to the plugin so tables can be queried offline.
+
+Each service with fixtures is served on its own local endpoint, and the
+`endpoints` connection option points the plugin at them:
+
+	fixtures, err := ocitest.LoadFixtures("testdata/core_vcn")
+	server, err := ocitest.NewServer(fixtures)
+	defer server.Close()
+	config := server.Config() // connection config of the fake tenancy
+
+Requests of services without fixtures are not redirected, so fixtures for the
+identity calls of the compartment and region matrix are required as well.
+*/
+package ocitest
+
+import (
+	"crypto/md5"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	// TenancyOCID is the tenancy of the fake server
+	TenancyOCID = "ocid1.tenancy.oc1..aaaaaaaafake"
+	// UserOCID is the user of the fake server
+	UserOCID = "ocid1.user.oc1..aaaaaaaafake"
+	// Region is the region of the fake server
+	Region = "us-ashburn-1"
+)
+
+// Request is a request received by the fake server
+type Request struct {
+	Service string
+	Method  string
+	Path    string
+	Query   url.Values
+	// request body, e.g. the details of a POST request
+	Body string
+	// true if the request carries the signature of the SDK
+	Signed bool
+}
+
+// Server is a fake OCI API server
+type Server struct {
+	PrivateKey  string
+	Fingerprint string
+
+	fixtures  []Fixture
+	servers   map[string]*httptest.Server
+	mutex     sync.Mutex
+	requests  []Request
+	unmatched []Request
+}
+
+// NewServer starts a fake OCI API server for the services of the fixtures
+func NewServer(fixtures []Fixture) (*Server, error) {
+	// the SDK signs every request, so the fake tenancy needs an API key
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, err
+	}
+	publicKey, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	privateKey := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+
+	fingerprint := []string{}
+	for _, b := range md5.Sum(publicKey) {
+		fingerprint = append(fingerprint, fmt.Sprintf("%02x", b))
+	}
+
+	s := &Server{
+		PrivateKey:  string(privateKey),
+		Fingerprint: strings.Join(fingerprint, ":"),
+		fixtures:    fixtures,
+		servers:     map[string]*httptest.Server{},
+	}
+	for _, fixture := range fixtures {
+		if _, ok := s.servers[fixture.Service]; !ok {
+			s.servers[fixture.Service] = httptest.NewServer(s.handler(fixture.Service))
+		}
+	}
+	return s, nil
+}
+
+// Close shuts down the endpoints of all services
+func (s *Server) Close() {
+	for _, server := range s.servers {
+		server.Close()
+	}
+}
+
+// URL returns the endpoint of a service
+func (s *Server) URL(service string) string {
+	if server, ok := s.servers[service]; ok {
+		return server.URL
+	}
+	return ""
+}
+
+// Endpoints returns the `endpoints` connection option for the services of the server
+func (s *Server) Endpoints() []string {
+	endpoints := []string{}
+	for service, server := range s.servers {
+		endpoints = append(endpoints, fmt.Sprintf("%s=%s", service, server.URL))
+	}
+	sort.Strings(endpoints)
+	return endpoints
+}
+
+// Config returns the connection config of the fake tenancy
+func (s *Server) Config() string {
+	endpoints := []string{}
+	for _, endpoint := range s.Endpoints() {
+		endpoints = append(endpoints, strconv.Quote(endpoint))
+	}
+
+	return fmt.Sprintf(`auth         = "ApiKey"
+tenancy_ocid = %q
+user_ocid    = %q
+fingerprint  = %q
+private_key  = <<EOT
+%sEOT
+regions      = [%q]
+endpoints    = [%s]
+`, TenancyOCID, UserOCID, s.Fingerprint, s.PrivateKey, Region, strings.Join(endpoints, ", "))
+}
+
+// Requests returns the requests received by the server
+func (s *Server) Requests() []Request {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return append([]Request{}, s.requests...)
+}
+
+// Unmatched returns the requests for which the server has no fixture
+func (s *Server) Unmatched() []Request {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return append([]Request{}, s.unmatched...)
+}
+
+func (s *Server) handler(service string) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "InvalidParameter", err.Error())
+			return
+		}
+		request := Request{
+			Service: service,
+			Method:  req.Method,
+			Path:    req.URL.Path,
+			Query:   req.URL.Query(),
+			Body:    string(body),
+			Signed:  strings.HasPrefix(req.Header.Get("Authorization"), "Signature "),
+		}
+
+		s.mutex.Lock()
+		s.requests = append(s.requests, request)
+		requestId := fmt.Sprintf("ocitest/%d", len(s.requests))
+		var fixture *Fixture
+		for i := range s.fixtures {
+			if s.fixtures[i].Service == service && s.fixtures[i].matches(req, request.Body) {
+				fixture = &s.fixtures[i]
+				break
+			}
+		}
+		if fixture == nil {
+			s.unmatched = append(s.unmatched, request)
+		}
+		s.mutex.Unlock()
+
+		w.Header().Set("opc-request-id", requestId)
+		w.Header().Set("Content-Type", "application/json")
+
+		if fixture == nil {
+			writeError(w, http.StatusNotFound, "NotAuthorizedOrNotFound", fmt.Sprintf("no fixture for %s %s", req.Method, req.URL.Path))
+			return
+		}
+		for key, value := range fixture.Headers {
+			w.Header().Set(key, value)
+		}
+		if fixture.Status >= 400 {
+			writeError(w, fixture.Status, fixture.Code, fixture.Message)
+			return
+		}
+
+		body = fixture.Body
+		if len(fixture.Pages) > 0 {
+			page := 0
+			if token := req.URL.Query().Get("page"); token != "" {
+				var err error
+				page, err = strconv.Atoi(token)
+				if err != nil || page < 0 || page >= len(fixture.Pages) {
+					writeError(w, http.StatusBadRequest, "InvalidParameter", fmt.Sprintf("invalid page %s", token))
+					return
+				}
+			}
+			if page+1 < len(fixture.Pages) {
+				w.Header().Set("opc-next-page", strconv.Itoa(page+1))
+			}
+			body = fixture.Pages[page]
+		}
+
+		status := fixture.Status
+		if status == 0 {
+			status = http.StatusOK
+		}
+		w.WriteHeader(status)
+		if len(body) > 0 {
+			w.Write(body)
+		}
+	}
+}
+
+// writeError writes the failure response of an OCI service
+func writeError(w http.ResponseWriter, status int, code string, message string) {
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]string{"code": code, "message": message})
+}
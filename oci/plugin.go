@@ -20,8 +20,11 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 	p := &plugin.Plugin{
 		Name:             pluginName,
 		DefaultTransform: transform.FromGo(),
+		DefaultIgnoreConfig: &plugin.IgnoreConfig{
+			ShouldIgnoreErrorFunc: shouldIgnoreErrorFromConfig,
+		},
 		DefaultGetConfig: &plugin.GetConfig{
-			ShouldIgnoreError: isNotFoundError([]string{"404"}),
+			IgnoreConfig: &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: isNotFoundError([]string{"404"})},
 		},
 		ConnectionConfigSchema: &plugin.ConnectionConfigSchema{
 			NewInstance: ConfigInstance,
@@ -116,6 +119,8 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"oci_kms_vault":                                                tableKmsVault(ctx),
 			"oci_logging_log":                                              tableLoggingLog(ctx),
 			"oci_logging_log_group":                                        tableLoggingLogGroup(ctx),
+			"oci_monitoring_metric":                                        tableMonitoringMetric(ctx),
+			"oci_monitoring_metric_definition":                             tableMonitoringMetricDefinition(ctx),
 			"oci_mysql_backup":                                             tableMySQLBackup(ctx),
 			"oci_mysql_channel":                                            tableMySQLChannel(ctx),
 			"oci_mysql_configuration":                                      tableMySQLConfiguration(ctx),
@@ -144,6 +149,8 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"oci_objectstorage_object":                                     tableObjectStorageObject(ctx),
 			"oci_ons_notification_topic":                                   tableOnsNotificationTopic(ctx),
 			"oci_ons_subscription":                                         tableOnsSubscription(ctx),
+			"oci_plugin_api_call":                                          tablePluginApiCall(ctx),
+			"oci_plugin_skipped_region":                                    tablePluginSkippedRegion(ctx),
 			"oci_region":                                                   tableIdentityRegion(ctx),
 			"oci_resource_search":                                          tableResourceSearch(ctx),
 			"oci_resourcemanager_stack":                                    tableOciResourceManagerStack(ctx),
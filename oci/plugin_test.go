@@ -0,0 +1,87 @@
+package oci
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/turbot/steampipe-plugin-oci/oci/ocitest"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+)
+
+// executeStream collects the rows streamed by the plugin
+type executeStream struct {
+	proto.WrapperPlugin_ExecuteServer
+	ctx context.Context
+
+	mutex sync.Mutex
+	rows  []map[string]*proto.Column
+}
+
+func (s *executeStream) Send(response *proto.ExecuteResponse) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if response.Row != nil {
+		s.rows = append(s.rows, response.Row.Columns)
+	}
+	return nil
+}
+
+func (s *executeStream) Context() context.Context {
+	return s.ctx
+}
+
+// queryTable runs a query of a table against the fake OCI API server of the
+// fixtures of a directory, and returns the rows and the server
+func queryTable(t *testing.T, fixtureDir string, table string, columns []string, quals map[string]string) ([]map[string]*proto.Column, *ocitest.Server, error) {
+	t.Helper()
+	server := newTestServer(t, fixtureDir)
+	rows, err := queryConnection(t, "oci_"+t.Name(), server.Config(), table, columns, quals)
+	return rows, server, err
+}
+
+// newTestServer starts a fake OCI API server for the fixtures of a directory
+func newTestServer(t *testing.T, fixtureDir string) *ocitest.Server {
+	t.Helper()
+	fixtures, err := ocitest.LoadFixtures(fixtureDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	server, err := ocitest.NewServer(fixtures)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(server.Close)
+	return server
+}
+
+// queryConnection runs a query of a table in a new plugin with a connection
+// of the given config. Every query should use its own connection, so
+// connection scoped state is not shared.
+func queryConnection(t *testing.T, connection string, config string, table string, columns []string, quals map[string]string) ([]map[string]*proto.Column, error) {
+	t.Helper()
+	p := Plugin(context.Background())
+	p.Initialise()
+	if err := p.SetConnectionConfig(connection, config); err != nil {
+		t.Fatal(err)
+	}
+
+	queryContext := &proto.QueryContext{Columns: columns, Quals: map[string]*proto.Quals{}}
+	for column, value := range quals {
+		queryContext.Quals[column] = &proto.Quals{Quals: []*proto.Qual{{
+			FieldName: column,
+			Operator:  &proto.Qual_StringValue{StringValue: "="},
+			Value:     &proto.QualValue{Value: &proto.QualValue_StringValue{StringValue: value}},
+		}}}
+	}
+	request := &proto.ExecuteRequest{
+		Table:                 table,
+		QueryContext:          queryContext,
+		CallId:                t.Name(),
+		ExecuteConnectionData: map[string]*proto.ExecuteConnectionData{connection: {}},
+	}
+
+	stream := &executeStream{ctx: context.Background()}
+	err := p.Execute(request, stream)
+	return stream.rows, err
+}
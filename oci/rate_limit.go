@@ -0,0 +1,139 @@
+package oci
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	oci_common "github.com/oracle/oci-go-sdk/v44/common"
+)
+
+// rateLimitDefault is the key of the `rate_limits` entry applied to services without their own limit
+const rateLimitDefault = "default"
+
+// default requests per second of a service and region, derived from the
+// published OCI API limits. Identity, Audit and Monitoring throttle far below
+// the other services, so wide matrices are spread out instead of running into 429s.
+var defaultRateLimits = map[string]float64{
+	rateLimitDefault:  20,
+	"audit":           5,
+	"identity":        10,
+	"monitoring":      10,
+	"resource_search": 10,
+}
+
+// tokenBucket is a limiter which allows `rate` requests per second, with bursts of up to `burst` requests
+type tokenBucket struct {
+	mutex  sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate float64) *tokenBucket {
+	burst := rate
+	if burst < 1 {
+		burst = 1
+	}
+	return &tokenBucket{rate: rate, burst: burst, tokens: burst, last: time.Now()}
+}
+
+// wait blocks until a request can be sent, or the context is done
+func (b *tokenBucket) wait(ctx context.Context) error {
+	for {
+		b.mutex.Lock()
+		now := time.Now()
+		b.tokens += now.Sub(b.last).Seconds() * b.rate
+		if b.tokens > b.burst {
+			b.tokens = b.burst
+		}
+		b.last = now
+
+		if b.tokens >= 1 {
+			b.tokens--
+			b.mutex.Unlock()
+			return nil
+		}
+		delay := time.Duration((1 - b.tokens) / b.rate * float64(time.Second))
+		b.mutex.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+}
+
+var rateLimiters = map[string]*tokenBucket{}
+var rateLimitersLock sync.Mutex
+
+// getRateLimiter returns the limiter shared by all clients of a service in a
+// tenancy and region, or nil if the service is not rate limited
+func getRateLimiter(connection string, tenancy string, service string, region string, rate float64) *tokenBucket {
+	if rate <= 0 {
+		return nil
+	}
+
+	rateLimitersLock.Lock()
+	defer rateLimitersLock.Unlock()
+
+	key := fmt.Sprintf("%s-%s-%s-%s", connection, tenancy, service, region)
+	limiter, ok := rateLimiters[key]
+	// a changed connection config replaces the limiter
+	if !ok || limiter.rate != rate {
+		limiter = newTokenBucket(rate)
+		rateLimiters[key] = limiter
+	}
+	return limiter
+}
+
+/*
+Each entry of the `rate_limits` list sets the requests per second of a service
+in each tenancy and region, using the service names of the `serviceClient`
+definitions in service.go. The `default` entry applies to all other services,
+and a rate of 0 disables rate limiting:
+
+	rate_limits = ["identity=5", "monitoring=2", "default=50"]
+*/
+func getServiceRateLimit(config ociConfig, service string) (float64, error) {
+	limits := map[string]float64{}
+	for key, rate := range defaultRateLimits {
+		limits[key] = rate
+	}
+
+	for _, entry := range config.RateLimits {
+		kv := strings.SplitN(entry, "=", 2)
+		if len(kv) != 2 {
+			return 0, fmt.Errorf("connection config has invalid rate limit '%s', expected service=requests_per_second. Edit your connection configuration file and then restart Steampipe", entry)
+		}
+		rate, err := strconv.ParseFloat(strings.TrimSpace(kv[1]), 64)
+		if err != nil || rate < 0 {
+			return 0, fmt.Errorf("connection config has invalid rate limit '%s', expected service=requests_per_second. Edit your connection configuration file and then restart Steampipe", entry)
+		}
+		limits[strings.TrimSpace(kv[0])] = rate
+	}
+
+	if rate, ok := limits[service]; ok {
+		return rate, nil
+	}
+	return limits[rateLimitDefault], nil
+}
+
+// rateLimitedDispatcher waits for the rate limiter of the service before sending a request
+type rateLimitedDispatcher struct {
+	dispatcher oci_common.HTTPRequestDispatcher
+	limiter    *tokenBucket
+}
+
+func (r rateLimitedDispatcher) Do(req *http.Request) (*http.Response, error) {
+	if err := r.limiter.wait(req.Context()); err != nil {
+		return nil, err
+	}
+	return r.dispatcher.Do(req)
+}
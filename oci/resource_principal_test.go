@@ -0,0 +1,339 @@
+package oci
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	oci_common "github.com/oracle/oci-go-sdk/v44/common"
+	oci_common_auth "github.com/oracle/oci-go-sdk/v44/common/auth"
+)
+
+const testInstanceOCID = "ocid1.instance.oc1.iad.aaaaaaaatest"
+
+// testJWT returns an unsigned token with the given claims, which the SDK only decodes
+func testJWT(claims map[string]interface{}) string {
+	payload, _ := json.Marshal(claims)
+	return "eyJhbGciOiJSUzI1NiJ9." + base64.RawURLEncoding.EncodeToString(payload) + ".signature"
+}
+
+// fakeResourcePrincipalServices are the instance metadata service, the auth
+// service and the resource principal token service of a version 1.1 resource
+// principal, e.g. of an instance running a data science notebook
+type fakeResourcePrincipalServices struct {
+	server *httptest.Server
+	// certificate and key of the instance principal
+	certificate []byte
+	key         []byte
+	// lifetime of the issued resource principal session tokens
+	lifetime time.Duration
+
+	mutex sync.Mutex
+	// resources the resource principal tokens were requested for
+	resources []string
+	// issued resource principal session tokens
+	sessionTokens []string
+}
+
+func newFakeResourcePrincipalServices(t *testing.T, lifetime time.Duration) *fakeResourcePrincipalServices {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: testInstanceOCID, OrganizationalUnit: []string{"opc-instance:" + testInstanceOCID, "opc-tenant:" + testTenancyOCID}},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	certificate, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	services := &fakeResourcePrincipalServices{
+		certificate: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificate}),
+		key:         pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}),
+		lifetime:    lifetime,
+	}
+	services.server = httptest.NewServer(http.HandlerFunc(services.serveHTTP))
+	t.Cleanup(services.server.Close)
+	return services
+}
+
+func (f *fakeResourcePrincipalServices) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	switch {
+	// instance metadata service
+	case r.URL.Path == "/opc/v2/instance/region":
+		fmt.Fprint(w, "us-ashburn-1")
+	case r.URL.Path == "/opc/v2/instance/id":
+		fmt.Fprint(w, testInstanceOCID)
+	case r.URL.Path == "/opc/v2/identity/cert.pem" || r.URL.Path == "/opc/v2/identity/intermediate.pem":
+		w.Write(f.certificate)
+	case r.URL.Path == "/opc/v2/identity/key.pem":
+		w.Write(f.key)
+
+	// auth service, which issues the instance principal and resource principal session tokens
+	case r.Method == http.MethodPost && r.URL.Path == "/v1/x509":
+		json.NewEncoder(w).Encode(map[string]string{"token": testJWT(map[string]interface{}{"exp": time.Now().Add(time.Hour).Unix()})})
+	case r.Method == http.MethodPost && r.URL.Path == "/v1/resourcePrincipalSessionToken":
+		var body map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || !strings.HasPrefix(body["resourcePrincipalToken"], "rpt-") || body["sessionPublicKey"] == "" {
+			http.Error(w, "invalid resource principal token", http.StatusBadRequest)
+			return
+		}
+		token := testJWT(map[string]interface{}{
+			"exp":        time.Now().Add(f.lifetime).Unix(),
+			"res_tenant": testTenancyOCID,
+			"jti":        fmt.Sprintf("rpst-%d", len(f.sessionTokens)),
+		})
+		f.sessionTokens = append(f.sessionTokens, token)
+		json.NewEncoder(w).Encode(map[string]string{"token": token})
+
+	// resource principal token service, signed with the instance principal
+	case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/20180711/resourcePrincipalToken/"):
+		if !strings.Contains(r.Header.Get("Authorization"), `keyId="ST$`) {
+			http.Error(w, "not signed by an instance principal", http.StatusUnauthorized)
+			return
+		}
+		resource := strings.TrimPrefix(r.URL.Path, "/20180711/resourcePrincipalToken/")
+		f.resources = append(f.resources, resource)
+		json.NewEncoder(w).Encode(map[string]string{"resourcePrincipalToken": "rpt-" + resource, "servicePrincipalSessionToken": "spst"})
+
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (f *fakeResourcePrincipalServices) issued() []string {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return append([]string{}, f.sessionTokens...)
+}
+
+// setResourcePrincipal11Env points a version 1.1 resource principal at the
+// fake services, and the instance metadata service address at the server
+func (f *fakeResourcePrincipalServices) setResourcePrincipal11Env(t *testing.T) {
+	t.Setenv(oci_common_auth.ResourcePrincipalVersionEnvVar, oci_common_auth.ResourcePrincipalVersion1_1)
+	t.Setenv(oci_common_auth.ResourcePrincipalTokenEndpoint, f.server.URL)
+	t.Setenv(oci_common_auth.ResourcePrincipalSessionTokenEndpoint, f.server.URL)
+	t.Setenv("OCI_SDK_AUTH_CLIENT_REGION_URL", f.server.URL)
+
+	// the SDK requests the metadata service through the default transport
+	transport := http.DefaultTransport
+	http.DefaultTransport = &http.Transport{
+		DialContext: func(ctx context.Context, network string, address string) (net.Conn, error) {
+			if address == "169.254.169.254:80" {
+				address = f.server.Listener.Addr().String()
+			}
+			return (&net.Dialer{Timeout: 5 * time.Second}).DialContext(ctx, network, address)
+		},
+	}
+	t.Cleanup(func() { http.DefaultTransport = transport })
+}
+
+func TestResourcePrincipal11(t *testing.T) {
+	services := newFakeResourcePrincipalServices(t, time.Hour)
+	services.setResourcePrincipal11Env(t)
+
+	provider, err := getProviderForResourcePrincipal("eu-frankfurt-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the connection region is queried, not the region of the instance
+	if region, err := provider.Region(); err != nil || region != "eu-frankfurt-1" {
+		t.Errorf("Region() = %s, %v, want eu-frankfurt-1", region, err)
+	}
+	if tenancy, err := provider.TenancyOCID(); err != nil || tenancy != testTenancyOCID {
+		t.Errorf("TenancyOCID() = %s, %v, want %s", tenancy, err, testTenancyOCID)
+	}
+	for i := 0; i < 3; i++ {
+		keyID, err := provider.KeyID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if issued := services.issued(); len(issued) != 1 || keyID != "ST$"+issued[0] {
+			t.Errorf("KeyID() = %s, want the session token issued for the resource principal", keyID)
+		}
+	}
+	if _, err := provider.PrivateRSAKey(); err != nil {
+		t.Fatal(err)
+	}
+
+	// the resource principal token is requested for the instance of the metadata service
+	if len(services.resources) != 1 || services.resources[0] != testInstanceOCID {
+		t.Errorf("resource principal tokens requested for %v, want %s", services.resources, testInstanceOCID)
+	}
+}
+
+func TestResourcePrincipal11Refresh(t *testing.T) {
+	// session tokens which expire within the refresh window of the SDK are renewed on every use
+	services := newFakeResourcePrincipalServices(t, time.Minute)
+	services.setResourcePrincipal11Env(t)
+	t.Setenv(oci_common_auth.ResourceID, "ocid1.datasciencenotebooksession.oc1.iad.aaaaaaaatest")
+
+	provider, err := getProviderForResourcePrincipal("us-ashburn-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyIDs := map[string]bool{}
+	for i := 0; i < 3; i++ {
+		keyID, err := provider.KeyID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		keyIDs[keyID] = true
+	}
+	if issued := services.issued(); len(issued) != 3 || len(keyIDs) != 3 {
+		t.Errorf("issued %d session tokens for %d key ids, want 3", len(issued), len(keyIDs))
+	}
+
+	// the resource of the environment takes precedence over the metadata service
+	if services.resources[0] != "ocid1.datasciencenotebooksession.oc1.iad.aaaaaaaatest" {
+		t.Errorf("resource principal tokens requested for %v, want the resource of the environment", services.resources)
+	}
+}
+
+// sameProvider reports whether both providers are the same instance. Composed
+// providers are structs of a slice, which can not be compared directly.
+func sameProvider(a oci_common.ConfigurationProvider, b oci_common.ConfigurationProvider) bool {
+	va, vb := reflect.ValueOf(a), reflect.ValueOf(b)
+	if va.Type() != vb.Type() {
+		return false
+	}
+	if va.Kind() == reflect.Struct {
+		return va.Field(0).Pointer() == vb.Field(0).Pointer()
+	}
+	return va.Pointer() == vb.Pointer()
+}
+
+// writeTestResourcePrincipal writes the session token and key of a version
+// 2.2 resource principal, as mounted into an OCI Function
+func writeTestResourcePrincipal(t *testing.T, tokenPath string, keyPath string, expiresAt time.Time, jti string) string {
+	t.Helper()
+	token := testJWT(map[string]interface{}{"exp": expiresAt.Unix(), "res_tenant": testTenancyOCID, "jti": jti})
+	if err := os.WriteFile(tokenPath, []byte(token), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(keyPath); err == nil {
+		return token
+	}
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+func TestResourcePrincipal22(t *testing.T) {
+	dir := t.TempDir()
+	tokenPath := filepath.Join(dir, "rpst")
+	keyPath := filepath.Join(dir, "private.pem")
+	// the token expires within the refresh window of the SDK, so it is read again on every use
+	token := writeTestResourcePrincipal(t, tokenPath, keyPath, time.Now().Add(time.Minute), "rpst-1")
+
+	t.Setenv(oci_common_auth.ResourcePrincipalVersionEnvVar, oci_common_auth.ResourcePrincipalVersion2_2)
+	t.Setenv(oci_common_auth.ResourcePrincipalRPSTEnvVar, tokenPath)
+	t.Setenv(oci_common_auth.ResourcePrincipalPrivatePEMEnvVar, keyPath)
+	t.Setenv(oci_common_auth.ResourcePrincipalRegionEnvVar, "us-phoenix-1")
+
+	d := newTestQueryData(t, "auth = \"ResourcePrincipal\"\nregions = [\"us-ashburn-1\", \"eu-frankfurt-1\"]\n")
+	config := GetConfig(d.Connection)
+
+	providers := map[string]oci_common.ConfigurationProvider{}
+	for _, region := range []string{"us-ashburn-1", "eu-frankfurt-1"} {
+		provider, err := getProvider(newTestContext(), d.ConnectionManager, region, config)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// the connection region is queried, not the region of the resource principal
+		if got, err := provider.Region(); err != nil || got != region {
+			t.Errorf("Region() = %s, %v, want %s", got, err, region)
+		}
+		if tenancy, err := provider.TenancyOCID(); err != nil || tenancy != testTenancyOCID {
+			t.Errorf("TenancyOCID() = %s, %v, want %s", tenancy, err, testTenancyOCID)
+		}
+		if keyID, err := provider.KeyID(); err != nil || keyID != "ST$"+token {
+			t.Errorf("KeyID() = %s, %v, want the session token of the environment", keyID, err)
+		}
+		providers[region] = provider
+	}
+
+	// providers are cached per region
+	if sameProvider(providers["us-ashburn-1"], providers["eu-frankfurt-1"]) {
+		t.Error("getProvider returned the same provider for both regions")
+	}
+	provider, err := getProvider(newTestContext(), d.ConnectionManager, "", config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !sameProvider(provider, providers["us-ashburn-1"]) {
+		t.Error("getProvider did not return the cached provider of the default region")
+	}
+
+	// a session token refreshed by the service is used by the cached provider
+	refreshed := writeTestResourcePrincipal(t, tokenPath, keyPath, time.Now().Add(time.Hour), "rpst-2")
+	if keyID, err := provider.KeyID(); err != nil || keyID != "ST$"+refreshed {
+		t.Errorf("KeyID() = %s, %v, want the refreshed session token", keyID, err)
+	}
+
+	// a valid session token is kept until it is about to expire
+	writeTestResourcePrincipal(t, tokenPath, keyPath, time.Now().Add(time.Hour), "rpst-3")
+	if keyID, err := provider.KeyID(); err != nil || keyID != "ST$"+refreshed {
+		t.Errorf("KeyID() = %s, %v, want the valid session token", keyID, err)
+	}
+}
+
+func TestResourcePrincipal11Provider(t *testing.T) {
+	services := newFakeResourcePrincipalServices(t, time.Minute)
+	services.setResourcePrincipal11Env(t)
+
+	d := newTestQueryData(t, "auth = \"ResourcePrincipal\"\nregions = [\"us-ashburn-1\"]\n")
+	provider, err := getProvider(newTestContext(), d.ConnectionManager, "us-ashburn-1", GetConfig(d.Connection))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := provider.KeyID(); err != nil {
+		t.Fatal(err)
+	}
+
+	// the cached provider renews the session token before it expires, without a restart
+	cached, err := getProvider(newTestContext(), d.ConnectionManager, "us-ashburn-1", GetConfig(d.Connection))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !sameProvider(cached, provider) {
+		t.Fatal("getProvider did not return the cached provider")
+	}
+	keyID, err := cached.KeyID()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if issued := services.issued(); len(issued) != 2 || keyID != "ST$"+issued[1] {
+		t.Errorf("KeyID() = %s, want the renewed session token", keyID)
+	}
+}
@@ -0,0 +1,137 @@
+package oci
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	oci_common "github.com/oracle/oci-go-sdk/v44/common"
+)
+
+// securityTokenProvider wraps the configuration provider of a profile using
+// SecurityToken authentication. The token file is re-read whenever it changes on
+// disk, so a session refreshed in the background with `oci session refresh` is
+// picked up by the cached provider and by every session client built from it.
+// Once the token has expired, the cached provider and the cached sessions built
+// from it are dropped, so a re-authenticated profile is read again.
+type securityTokenProvider struct {
+	oci_common.ConfigurationProvider
+	profile   string
+	tokenPath string
+
+	// called with the cache keys of the sessions built from the provider once
+	// the token has expired and was not refreshed on disk
+	onExpired func(sessionCacheKeys []string)
+
+	mutex            sync.Mutex
+	token            string
+	modTime          time.Time
+	expiresAt        time.Time
+	sessionCacheKeys []string
+	// the expired token whose sessions were invalidated
+	expiredToken string
+}
+
+func newSecurityTokenProvider(provider oci_common.ConfigurationProvider, profile string, tokenPath string, onExpired func(sessionCacheKeys []string)) (*securityTokenProvider, error) {
+	p := &securityTokenProvider{
+		ConfigurationProvider: provider,
+		profile:               profile,
+		tokenPath:             expandPath(tokenPath),
+		onExpired:             onExpired,
+	}
+	if _, err := p.getToken(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// getToken returns the current security token, re-reading the token file if it was modified.
+// The sessions of an expired token are invalidated once, after the lock is released.
+func (p *securityTokenProvider) getToken() (string, error) {
+	token, expired, err := p.readToken()
+	if expired != nil && p.onExpired != nil {
+		p.onExpired(expired)
+	}
+	return token, err
+}
+
+// readToken returns the current security token. If the token has expired, it also
+// returns the cache keys of its sessions, the first time the expiry is seen.
+func (p *securityTokenProvider) readToken() (string, []string, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	info, err := os.Stat(p.tokenPath)
+	if err != nil {
+		return "", nil, fmt.Errorf("can not read security token of profile '%s': %s", p.profile, err.Error())
+	}
+
+	if p.token == "" || !info.ModTime().Equal(p.modTime) {
+		data, err := os.ReadFile(p.tokenPath)
+		if err != nil {
+			return "", nil, fmt.Errorf("can not read security token of profile '%s': %s", p.profile, err.Error())
+		}
+		token := strings.TrimSpace(string(data))
+		expiresAt, err := getSecurityTokenExpiry(token)
+		if err != nil {
+			return "", nil, fmt.Errorf("security token of profile '%s' is invalid: %s", p.profile, err.Error())
+		}
+		p.token = token
+		p.modTime = info.ModTime()
+		p.expiresAt = expiresAt
+	}
+
+	if time.Now().After(p.expiresAt) {
+		var expired []string
+		if p.expiredToken != p.token {
+			p.expiredToken = p.token
+			expired = append([]string{}, p.sessionCacheKeys...)
+			p.sessionCacheKeys = nil
+		}
+		return "", expired, fmt.Errorf("security token of profile '%s' expired at %s. Run 'oci session refresh --profile %s' to refresh it", p.profile, p.expiresAt.Format(time.RFC3339), p.profile)
+	}
+
+	return p.token, nil, nil
+}
+
+// addSessionCacheKey records the cache key of a session built from the provider
+func (p *securityTokenProvider) addSessionCacheKey(key string) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.sessionCacheKeys = append(p.sessionCacheKeys, key)
+}
+
+func (p *securityTokenProvider) KeyID() (string, error) {
+	token, err := p.getToken()
+	if err != nil {
+		return "", err
+	}
+	return "ST$" + token, nil
+}
+
+// getProfileSetting returns the value of a setting of a profile in an OCI config file
+func getProfileSetting(configPath string, profile string, key string) (string, error) {
+	data, err := os.ReadFile(configPath)
+	if err != nil {
+		return "", err
+	}
+
+	inProfile := false
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if match := strings.TrimSuffix(strings.TrimPrefix(line, "["), "]"); strings.HasPrefix(line, "[") {
+			inProfile = match == profile
+			continue
+		}
+		if !inProfile {
+			continue
+		}
+		if kv := strings.SplitN(line, "=", 2); len(kv) == 2 && strings.TrimSpace(kv[0]) == key {
+			return strings.TrimSpace(kv[1]), nil
+		}
+	}
+
+	return "", fmt.Errorf("profile '%s' in configuration file '%s' has no '%s' setting", profile, configPath, key)
+}
@@ -0,0 +1,111 @@
+package oci
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// writeTestSecurityToken writes a security token expiring at the given time
+func writeTestSecurityToken(t *testing.T, path string, expiresAt time.Time, modTime time.Time) {
+	t.Helper()
+	claims, _ := json.Marshal(map[string]interface{}{"exp": expiresAt.Unix()})
+	token := "eyJhbGciOiJSUzI1NiJ9." + base64.RawURLEncoding.EncodeToString(claims) + ".signature"
+	if err := os.WriteFile(path, []byte(token+"\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(path, modTime, modTime); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSecurityTokenProviderExpired(t *testing.T) {
+	tokenPath := filepath.Join(t.TempDir(), "token")
+	writeTestSecurityToken(t, tokenPath, time.Now().Add(time.Hour), time.Now().Add(-time.Minute))
+
+	var expiredSessions [][]string
+	provider, err := newSecurityTokenProvider(nil, "DEFAULT", tokenPath, func(sessionCacheKeys []string) {
+		expiredSessions = append(expiredSessions, sessionCacheKeys)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	provider.addSessionCacheKey("core-tenancy-us-ashburn-1")
+	provider.addSessionCacheKey("identity-tenancy-us-ashburn-1")
+
+	if _, err := provider.KeyID(); err != nil {
+		t.Fatal(err)
+	}
+	if len(expiredSessions) != 0 {
+		t.Fatalf("sessions of a valid token were invalidated: %v", expiredSessions)
+	}
+
+	// the token file is replaced by an expired token
+	writeTestSecurityToken(t, tokenPath, time.Now().Add(-time.Minute), time.Now())
+	if _, err := provider.KeyID(); err == nil || !strings.Contains(err.Error(), "expired") {
+		t.Errorf("KeyID() error = %v, want an expired token error", err)
+	}
+	want := [][]string{{"core-tenancy-us-ashburn-1", "identity-tenancy-us-ashburn-1"}}
+	if !reflect.DeepEqual(expiredSessions, want) {
+		t.Errorf("invalidated sessions = %v, want %v", expiredSessions, want)
+	}
+
+	// sessions are invalidated once per expired token
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			provider.KeyID()
+		}()
+	}
+	wg.Wait()
+	if len(expiredSessions) != 1 {
+		t.Errorf("sessions were invalidated %d times, want once", len(expiredSessions))
+	}
+}
+
+func TestSecurityTokenProviderExpiredCallback(t *testing.T) {
+	tokenPath := filepath.Join(t.TempDir(), "token")
+	writeTestSecurityToken(t, tokenPath, time.Now().Add(time.Hour), time.Now().Add(-time.Minute))
+
+	// the callback is invoked without the lock of the provider, so it may use the provider
+	var provider *securityTokenProvider
+	invalidated := make(chan []string, 1)
+	provider, err := newSecurityTokenProvider(nil, "DEFAULT", tokenPath, func(sessionCacheKeys []string) {
+		provider.addSessionCacheKey("rebuilt")
+		invalidated <- sessionCacheKeys
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	provider.addSessionCacheKey("core-tenancy-us-ashburn-1")
+
+	writeTestSecurityToken(t, tokenPath, time.Now().Add(-time.Minute), time.Now())
+	done := make(chan struct{})
+	go func() {
+		provider.KeyID()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the expiry callback deadlocked on the provider lock")
+	}
+	if keys := <-invalidated; !reflect.DeepEqual(keys, []string{"core-tenancy-us-ashburn-1"}) {
+		t.Errorf("invalidated sessions = %v", keys)
+	}
+
+	// a refreshed token which expires again invalidates its own sessions
+	writeTestSecurityToken(t, tokenPath, time.Now().Add(-time.Second), time.Now().Add(time.Second))
+	provider.KeyID()
+	if keys := <-invalidated; !reflect.DeepEqual(keys, []string{"rebuilt"}) {
+		t.Errorf("invalidated sessions of the second token = %v, want [rebuilt]", keys)
+	}
+}
@@ -3,15 +3,18 @@ package oci
 import (
 	"context"
 	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"net"
 	"net/http"
+	"net/url"
 	"os"
 	"os/user"
 	"path"
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/oracle/oci-go-sdk/v44/analytics"
 	"github.com/oracle/oci-go-sdk/v44/apigateway"
@@ -49,6 +52,8 @@ import (
 
 type session struct {
 	TenancyID                      string
+	Service                        string
+	Region                         string
 	AnalyticsClient                analytics.AnalyticsClient
 	ApiGatewayClient               apigateway.ApiGatewayClient
 	AuditClient                    audit.AuditClient
@@ -87,1376 +92,571 @@ type session struct {
 
 // apiGatewayService returns the service client for OCI ApiGateway service
 func apiGatewayService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
-	logger := plugin.Logger(ctx)
-
-	// have we already created and cached the service?
-	serviceCacheKey := fmt.Sprintf("apigateway-%s", region)
-	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
-		return cachedData.(*session), nil
-	}
-
-	// get oci config info from steampipe connection
-	ociConfig := GetConfig(d.Connection)
-
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
-	if err != nil {
-		logger.Error("apiGatewayService", "error_getProvider", err)
-		return nil, err
-	}
-
-	// get apigateway service client
-	client, err := apigateway.NewApiGatewayClientWithConfigurationProvider(provider)
-	if err != nil {
-		logger.Error("apiGatewayService", "error_NewApiGatewayClientWithConfigurationProvider", err)
-		return nil, err
-	}
-
-	// get tenant ocid from provider
-	tenantId, err := provider.TenancyOCID()
-	if err != nil {
-		logger.Error("apiGatewayService", "error_TenancyOCID", err)
-		return nil, err
-	}
-
-	sess := &session{
-		TenancyID:        tenantId,
-		ApiGatewayClient: client,
-	}
-
-	// save session in cache
-	d.ConnectionManager.Cache.Set(serviceCacheKey, sess)
-
-	return sess, nil
+	return getServiceSession(ctx, d, apiGatewayServiceClient, region)
 }
 
 // auditService returns the service client for OCI Audit service
 func auditService(ctx context.Context, d *plugin.QueryData) (*session, error) {
-
-	serviceCacheKey := fmt.Sprintf("audit-%s", "region")
-	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
-		return cachedData.(*session), nil
-	}
-
-	// get oci config info from steampipe connection
-	ociConfig := GetConfig(d.Connection)
-
-	provider, err := getProvider(ctx, d.ConnectionManager, "", ociConfig)
-	if err != nil {
-		return nil, err
-	}
-
-	// get audit service client
-	client, err := audit.NewAuditClientWithConfigurationProvider(provider)
-	if err != nil {
-		return nil, err
-	}
-
-	// get tenant ocid from provider
-	tenantId, err := provider.TenancyOCID()
-	if err != nil {
-		return nil, err
-	}
-
-	sess := &session{
-		TenancyID:   tenantId,
-		AuditClient: client,
-	}
-
-	// save session in cache
-	d.ConnectionManager.Cache.Set(serviceCacheKey, sess)
-
-	return sess, nil
+	return getServiceSession(ctx, d, auditServiceClient, "")
 }
 
 // autoScalingService returns the service client for OCI Auto Scaling Service
 func autoScalingService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
-	logger := plugin.Logger(ctx)
-
-	// have we already created and cached the service?
-	serviceCacheKey := fmt.Sprintf("autoscaling-%s", region)
-	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
-		return cachedData.(*session), nil
-	}
-
-	// get oci config info
-	ociConfig := GetConfig(d.Connection)
-
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
-	if err != nil {
-		logger.Error("autoScalingService", "getProvider.Error", err)
-		return nil, err
-	}
-
-	client, err := autoscaling.NewAutoScalingClientWithConfigurationProvider(provider)
-	if err != nil {
-		return nil, err
-	}
-
-	tenantId, err := provider.TenancyOCID()
-	if err != nil {
-		return nil, err
-	}
-
-	sess := &session{
-		TenancyID:         tenantId,
-		AutoScalingClient: client,
-	}
-
-	// save session in cache
-	d.ConnectionManager.Cache.Set(serviceCacheKey, sess)
-
-	return sess, nil
+	return getServiceSession(ctx, d, autoScalingServiceClient, region)
 }
 
 // identityService returns the service client for OCI Identity service
 func identityService(ctx context.Context, d *plugin.QueryData) (*session, error) {
-
-	serviceCacheKey := fmt.Sprintf("identity-%s", "region")
-	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
-		return cachedData.(*session), nil
-	}
-
-	// get oci config info from steampipe connection
-	ociConfig := GetConfig(d.Connection)
-
-	provider, err := getProvider(ctx, d.ConnectionManager, "", ociConfig)
-	if err != nil {
-		return nil, err
-	}
-
-	// get identity service client
-	client, err := identity.NewIdentityClientWithConfigurationProvider(provider)
-	if err != nil {
-		return nil, err
-	}
-
-	// get tenant ocid from provider
-	tenantId, err := provider.TenancyOCID()
-	if err != nil {
-		return nil, err
-	}
-
-	sess := &session{
-		TenancyID:      tenantId,
-		IdentityClient: client,
-	}
-
-	// save session in cache
-	d.ConnectionManager.Cache.Set(serviceCacheKey, sess)
-
-	return sess, nil
+	return getServiceSession(ctx, d, identityServiceClient, "")
 }
 
 // identityServiceRegional returns the service client for OCI Identity Regional Service
 func identityServiceRegional(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
-	logger := plugin.Logger(ctx)
-
-	// have we already created and cached the service?
-	serviceCacheKey := fmt.Sprintf("identityregional-%s", region)
-	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
-		return cachedData.(*session), nil
-	}
-
-	// get oci config info
-	ociConfig := GetConfig(d.Connection)
-
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
-	if err != nil {
-		logger.Error("identityServiceRegional", "getProvider.Error", err)
-		return nil, err
-	}
-
-	client, err := identity.NewIdentityClientWithConfigurationProvider(provider)
-	if err != nil {
-		return nil, err
-	}
-
-	tenantId, err := provider.TenancyOCID()
-	if err != nil {
-		return nil, err
-	}
-
-	sess := &session{
-		TenancyID:      tenantId,
-		IdentityClient: client,
-	}
-
-	// save session in cache
-	d.ConnectionManager.Cache.Set(serviceCacheKey, sess)
-
-	return sess, nil
+	return getServiceSession(ctx, d, identityServiceClient, region)
 }
 
 // loggingManagementService returns the service client for OCI Logging Management Service
 func loggingManagementService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
-	logger := plugin.Logger(ctx)
-
-	// have we already created and cached the service?
-	serviceCacheKey := fmt.Sprintf("loggingmanagement-%s", region)
-	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
-		return cachedData.(*session), nil
-	}
-
-	// get oci config info
-	ociConfig := GetConfig(d.Connection)
-
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
-	if err != nil {
-		logger.Error("loggingManagementService", "getProvider.Error", err)
-		return nil, err
-	}
-
-	client, err := logging.NewLoggingManagementClientWithConfigurationProvider(provider)
-	if err != nil {
-		return nil, err
-	}
-
-	tenantId, err := provider.TenancyOCID()
-	if err != nil {
-		return nil, err
-	}
-
-	sess := &session{
-		TenancyID:               tenantId,
-		LoggingManagementClient: client,
-	}
-
-	// save session in cache
-	d.ConnectionManager.Cache.Set(serviceCacheKey, sess)
-
-	return sess, nil
+	return getServiceSession(ctx, d, loggingManagementServiceClient, region)
 }
 
 // coreBlockStorageService returns the service client for OCI Core BlockStorage Service
 func coreBlockStorageService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
-	logger := plugin.Logger(ctx)
-
-	// have we already created and cached the service?
-	serviceCacheKey := fmt.Sprintf("blockstorage-%s", region)
-	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
-		return cachedData.(*session), nil
-	}
-
-	// get oci config info
-	ociConfig := GetConfig(d.Connection)
-
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
-	if err != nil {
-		logger.Error("coreBlockStorageService", "getProvider.Error", err)
-		return nil, err
-	}
-
-	client, err := core.NewBlockstorageClientWithConfigurationProvider(provider)
-	if err != nil {
-		return nil, err
-	}
-
-	tenantId, err := provider.TenancyOCID()
-	if err != nil {
-		return nil, err
-	}
-
-	sess := &session{
-		TenancyID:          tenantId,
-		BlockstorageClient: client,
-	}
-
-	// save session in cache
-	d.ConnectionManager.Cache.Set(serviceCacheKey, sess)
-
-	return sess, nil
+	return getServiceSession(ctx, d, blockStorageServiceClient, region)
 }
 
 // containerEngineService returns the service client for OCI Container Engine Service
 func containerEngineService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
-	logger := plugin.Logger(ctx)
-
-	// have we already created and cached the service?
-	serviceCacheKey := fmt.Sprintf("containerengine-%s", region)
-	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
-		return cachedData.(*session), nil
-	}
-
-	// get oci config info
-	ociConfig := GetConfig(d.Connection)
-
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
-	if err != nil {
-		logger.Error("containerEngineService", "getProvider.Error", err)
-		return nil, err
-	}
-
-	client, err := containerengine.NewContainerEngineClientWithConfigurationProvider(provider)
-	if err != nil {
-		return nil, err
-	}
-
-	tenantId, err := provider.TenancyOCID()
-	if err != nil {
-		return nil, err
-	}
-
-	sess := &session{
-		TenancyID:             tenantId,
-		ContainerEngineClient: client,
-	}
-
-	// save session in cache
-	d.ConnectionManager.Cache.Set(serviceCacheKey, sess)
-
-	return sess, nil
+	return getServiceSession(ctx, d, containerEngineServiceClient, region)
 }
 
 // eventsService returns the service client for OCI Events Service
 func eventsService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
-	logger := plugin.Logger(ctx)
-
-	// have we already created and cached the service?
-	serviceCacheKey := fmt.Sprintf("events-%s", region)
-	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
-		return cachedData.(*session), nil
-	}
-
-	// get oci config info
-	ociConfig := GetConfig(d.Connection)
-
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
-	if err != nil {
-		logger.Error("eventsService", "getProvider.Error", err)
-		return nil, err
-	}
-
-	client, err := events.NewEventsClientWithConfigurationProvider(provider)
-	if err != nil {
-		return nil, err
-	}
-
-	tenantId, err := provider.TenancyOCID()
-	if err != nil {
-		return nil, err
-	}
-
-	sess := &session{
-		TenancyID:    tenantId,
-		EventsClient: client,
-	}
-
-	// save session in cache
-	d.ConnectionManager.Cache.Set(serviceCacheKey, sess)
-
-	return sess, nil
+	return getServiceSession(ctx, d, eventsServiceClient, region)
 }
 
 // fileStorageService returns the service client for OCI File Storage Service
 func fileStorageService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
-	logger := plugin.Logger(ctx)
-
-	// have we already created and cached the service?
-	serviceCacheKey := fmt.Sprintf("filestorage-%s", region)
-	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
-		return cachedData.(*session), nil
-	}
-
-	// get oci config info
-	ociConfig := GetConfig(d.Connection)
-
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
-	if err != nil {
-		logger.Error("fileStorageService", "getProvider.Error", err)
-		return nil, err
-	}
-	client, err := filestorage.NewFileStorageClientWithConfigurationProvider(provider)
-	if err != nil {
-		return nil, err
-	}
-
-	tenantID, err := provider.TenancyOCID()
-	if err != nil {
-		return nil, err
-	}
-
-	sess := &session{
-		TenancyID:         tenantID,
-		FileStorageClient: client,
-	}
-
-	// save session in cache
-	d.ConnectionManager.Cache.Set(serviceCacheKey, sess)
-
-	return sess, nil
+	return getServiceSession(ctx, d, fileStorageServiceClient, region)
 }
 
 // functionsManagementService returns the service client for OCI Functions Management Service
 func functionsManagementService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
-	logger := plugin.Logger(ctx)
-	serviceCacheKey := fmt.Sprintf("functionsmanagement-%s", region)
-	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
-		return cachedData.(*session), nil
-	}
-
-	// get oci config info
-	ociConfig := GetConfig(d.Connection)
-
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
-	if err != nil {
-		logger.Error("functionsManagementService", "getProvider.Error", err)
-		return nil, err
-	}
-
-	client, err := functions.NewFunctionsManagementClientWithConfigurationProvider(provider)
-	if err != nil {
-		return nil, err
-	}
-
-	tenantID, err := provider.TenancyOCID()
-	if err != nil {
-		return nil, err
-	}
-
-	sess := &session{
-		TenancyID:                 tenantID,
-		FunctionsManagementClient: client,
-	}
-
-	// save session in cache
-	d.ConnectionManager.Cache.Set(serviceCacheKey, sess)
-
-	return sess, nil
+	return getServiceSession(ctx, d, functionsManagementServiceClient, region)
 }
 
 // kmsManagementService returns the service client for OCI KMS Management Service
 func kmsManagementService(ctx context.Context, d *plugin.QueryData, region string, endpoint string) (*session, error) {
-	logger := plugin.Logger(ctx)
-
 	// Cache the connection at vault level
-	serviceCacheKey := fmt.Sprintf("keymanagement-%s-%s", region, endpoint)
-	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
-		return cachedData.(*session), nil
-	}
-	// get oci config info
-	ociConfig := GetConfig(d.Connection)
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
-	if err != nil {
-		logger.Error("kmsManagementService", "getProvider.Error", err)
-		return nil, err
-	}
-
-	client, err := keymanagement.NewKmsManagementClientWithConfigurationProvider(provider, endpoint)
-	if err != nil {
-		return nil, err
-	}
-	tenantId, err := provider.TenancyOCID()
-	if err != nil {
-		return nil, err
-	}
-	sess := &session{
-		TenancyID:           tenantId,
-		KmsManagementClient: client,
-	}
-	// save session in cache
-	d.ConnectionManager.Cache.Set(serviceCacheKey, sess)
-	return sess, nil
+	service := kmsManagementServiceClient
+	service.endpoint = endpoint
+	return getServiceSession(ctx, d, service, region)
 }
 
 // kmsVaultService returns the service client for OCI KMS Vault Service
 func kmsVaultService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
-	logger := plugin.Logger(ctx)
-	serviceCacheKey := fmt.Sprintf("vault-%s", region)
-	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
-		return cachedData.(*session), nil
-	}
-
-	// get oci config info
-	ociConfig := GetConfig(d.Connection)
-
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
-	if err != nil {
-		logger.Error("kmsVaultService", "getProvider.Error", err)
-		return nil, err
-	}
-
-	client, err := keymanagement.NewKmsVaultClientWithConfigurationProvider(provider)
-	if err != nil {
-		return nil, err
-	}
-
-	tenantId, err := provider.TenancyOCID()
-	if err != nil {
-		return nil, err
-	}
-
-	sess := &session{
-		TenancyID:      tenantId,
-		KmsVaultClient: client,
-	}
-
-	// save session in cache
-	d.ConnectionManager.Cache.Set(serviceCacheKey, sess)
-
-	return sess, nil
+	return getServiceSession(ctx, d, kmsVaultServiceClient, region)
 }
 
 // loadBalancerService returns the service client for OCI Load Balancer Service
 func loadBalancerService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
-	logger := plugin.Logger(ctx)
-	serviceCacheKey := fmt.Sprintf("loadbalancer-%s", region)
-	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
-		return cachedData.(*session), nil
-	}
-
-	// get oci config info
-	ociConfig := GetConfig(d.Connection)
-
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
-	if err != nil {
-		logger.Error("loadBalancerService", "getProvider.Error", err)
-		return nil, err
-	}
-
-	client, err := loadbalancer.NewLoadBalancerClientWithConfigurationProvider(provider)
-	if err != nil {
-		return nil, err
-	}
-
-	tenantId, err := provider.TenancyOCID()
-	if err != nil {
-		return nil, err
-	}
-
-	sess := &session{
-		TenancyID:          tenantId,
-		LoadBalancerClient: client,
-	}
-
-	// save session in cache
-	d.ConnectionManager.Cache.Set(serviceCacheKey, sess)
-
-	return sess, nil
+	return getServiceSession(ctx, d, loadBalancerServiceClient, region)
 }
 
 // objectStorageService returns the service client for OCI Object Storage service
 func objectStorageService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
-	logger := plugin.Logger(ctx)
-	serviceCacheKey := fmt.Sprintf("objectstorage-%s", region)
-	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
-		return cachedData.(*session), nil
-	}
-
-	// get oci config info
-	ociConfig := GetConfig(d.Connection)
-
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
-	if err != nil {
-		logger.Error("objectStorageService", "getProvider.Error", err)
-		return nil, err
-	}
-
-	client, err := objectstorage.NewObjectStorageClientWithConfigurationProvider(provider)
-	if err != nil {
-		return nil, err
-	}
-
-	tenantId, err := provider.TenancyOCID()
-	if err != nil {
-		return nil, err
-	}
-
-	sess := &session{
-		TenancyID:           tenantId,
-		ObjectStorageClient: client,
-	}
-
-	// save session in cache
-	d.ConnectionManager.Cache.Set(serviceCacheKey, sess)
-
-	return sess, nil
+	return getServiceSession(ctx, d, objectStorageServiceClient, region)
 }
 
 // onsNotificationControlPlaneService returns the service client for OCI Notification Control Plane service
 func onsNotificationControlPlaneService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
-	logger := plugin.Logger(ctx)
-
-	// have we already created and cached the service?
-	serviceCacheKey := fmt.Sprintf("notificationcontrolplane-%s", region)
-	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
-		return cachedData.(*session), nil
-	}
-
-	// get oci config info from steampipe connection
-	ociConfig := GetConfig(d.Connection)
-
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
-	if err != nil {
-		logger.Error("onsNotificationControlPlaneService", "getProvider.Error", err)
-		return nil, err
-	}
-
-	// get notification service client
-	client, err := ons.NewNotificationControlPlaneClientWithConfigurationProvider(provider)
-	if err != nil {
-		return nil, err
-	}
-
-	// get tenant ocid from provider
-	tenantId, err := provider.TenancyOCID()
-	if err != nil {
-		return nil, err
-	}
-
-	sess := &session{
-		TenancyID:                      tenantId,
-		NotificationControlPlaneClient: client,
-	}
-
-	// save session in cache
-	d.ConnectionManager.Cache.Set(serviceCacheKey, sess)
-
-	return sess, nil
+	return getServiceSession(ctx, d, notificationControlPlaneServiceClient, region)
 }
 
 // onsNotificationDataPlaneService returns the service client for OCI Notification Data Plane service
 func onsNotificationDataPlaneService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
-	logger := plugin.Logger(ctx)
-
-	// have we already created and cached the service?
-	serviceCacheKey := fmt.Sprintf("notificationdataplane-%s", region)
-	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
-		return cachedData.(*session), nil
-	}
-
-	// get oci config info from steampipe connection
-	ociConfig := GetConfig(d.Connection)
-
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
-	if err != nil {
-		logger.Error("notificationDataPlaneService", "getProvider.Error", err)
-		return nil, err
-	}
-
-	// get notification data plane service client
-	client, err := ons.NewNotificationDataPlaneClientWithConfigurationProvider(provider)
-	if err != nil {
-		return nil, err
-	}
-
-	// get tenant ocid from provider
-	tenantId, err := provider.TenancyOCID()
-	if err != nil {
-		return nil, err
-	}
-
-	sess := &session{
-		TenancyID:                   tenantId,
-		NotificationDataPlaneClient: client,
-	}
-
-	// save session in cache
-	d.ConnectionManager.Cache.Set(serviceCacheKey, sess)
-
-	return sess, nil
-}
-
-// coreComputeService returns the service client for OCI Core Compute service
-func coreComputeService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
-	logger := plugin.Logger(ctx)
-
-	// have we already created and cached the service?
-	serviceCacheKey := fmt.Sprintf("computeregional-%s", region)
-	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
-		return cachedData.(*session), nil
-	}
-
-	// get oci config info from steampipe connection
-	ociConfig := GetConfig(d.Connection)
-
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
-	if err != nil {
-		logger.Error("coreComputeServiceRegional", "getProvider.Error", err)
-		return nil, err
-	}
-
-	// get compute service client
-	client, err := core.NewComputeClientWithConfigurationProvider(provider)
-	if err != nil {
-		return nil, err
-	}
-
-	// get tenant ocid from provider
-	tenantId, err := provider.TenancyOCID()
-	if err != nil {
-		return nil, err
-	}
-
-	sess := &session{
-		TenancyID:     tenantId,
-		ComputeClient: client,
-	}
-
-	// save session in cache
-	d.ConnectionManager.Cache.Set(serviceCacheKey, sess)
-
-	return sess, nil
-}
-
-// coreVirtualNetworkService returns the service client for OCI Core VirtualNetwork Service
-func coreVirtualNetworkService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
-	logger := plugin.Logger(ctx)
-	serviceCacheKey := fmt.Sprintf("virtualnetwork-%s", region)
-	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
-		return cachedData.(*session), nil
-	}
-
-	// get oci config info
-	ociConfig := GetConfig(d.Connection)
-
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
-	if err != nil {
-		logger.Error("coreVirtualNetworkService", "getProvider.Error", err)
-		return nil, err
-	}
-
-	client, err := core.NewVirtualNetworkClientWithConfigurationProvider(provider)
-	if err != nil {
-		return nil, err
-	}
-
-	tenantID, err := provider.TenancyOCID()
-	if err != nil {
-		return nil, err
-	}
-
-	sess := &session{
-		TenancyID:            tenantID,
-		VirtualNetworkClient: client,
-	}
-
-	// save session in cache
-	d.ConnectionManager.Cache.Set(serviceCacheKey, sess)
-
-	return sess, nil
-}
-
-// cloudGuardService returns the service client for OCI Cloud Guard Service
-func cloudGuardService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
-	logger := plugin.Logger(ctx)
-	serviceCacheKey := fmt.Sprintf("cloudguard-%s", region)
-	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
-		return cachedData.(*session), nil
-	}
-
-	// get oci config info
-	ociConfig := GetConfig(d.Connection)
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
-	if err != nil {
-		logger.Error("cloudGuardService", "getProvider.Error", err)
-		return nil, err
-	}
-
-	client, err := cloudguard.NewCloudGuardClientWithConfigurationProvider(provider)
-	if err != nil {
-		return nil, err
-	}
-
-	tenantID, err := provider.TenancyOCID()
-	if err != nil {
-		return nil, err
-	}
-
-	sess := &session{
-		TenancyID:        tenantID,
-		CloudGuardClient: client,
-	}
-
-	// save session in cache
-	d.ConnectionManager.Cache.Set(serviceCacheKey, sess)
-
-	return sess, nil
-}
-
-// dnsService returns the service client for OCI DNS Service
-func dnsService(ctx context.Context, d *plugin.QueryData) (*session, error) {
-	logger := plugin.Logger(ctx)
-	serviceCacheKey := fmt.Sprintf("dns-%s", "region")
-	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
-		return cachedData.(*session), nil
-	}
-
-	// get oci config info
-	ociConfig := GetConfig(d.Connection)
-
-	provider, err := getProvider(ctx, d.ConnectionManager, "", ociConfig)
-	if err != nil {
-		logger.Error("DNSService", "getProvider.Error", err)
-		return nil, err
-	}
-
-	client, err := dns.NewDnsClientWithConfigurationProvider(provider)
-	if err != nil {
-		return nil, err
-	}
-
-	tenantID, err := provider.TenancyOCID()
-	if err != nil {
-		return nil, err
-	}
-
-	sess := &session{
-		TenancyID: tenantID,
-		DnsClient: client,
-	}
-
-	// save session in cache
-	d.ConnectionManager.Cache.Set(serviceCacheKey, sess)
-
-	return sess, nil
-}
-
-// databaseService returns the service client for OCI Database Service
-func databaseService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
-	logger := plugin.Logger(ctx)
-	serviceCacheKey := fmt.Sprintf("database-%s", region)
-	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
-		return cachedData.(*session), nil
-	}
-
-	// get oci config info
-	ociConfig := GetConfig(d.Connection)
-
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
-	if err != nil {
-		logger.Error("databaseService", "getProvider.Error", err)
-		return nil, err
-	}
-
-	client, err := database.NewDatabaseClientWithConfigurationProvider(provider)
-	if err != nil {
-		return nil, err
-	}
-
-	tenantID, err := provider.TenancyOCID()
-	if err != nil {
-		return nil, err
-	}
-
-	sess := &session{
-		TenancyID:      tenantID,
-		DatabaseClient: client,
-	}
-
-	// save session in cache
-	d.ConnectionManager.Cache.Set(serviceCacheKey, sess)
-
-	return sess, nil
-}
-
-// budgetService returns the service client for OCI budget Service
-func budgetService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
-	logger := plugin.Logger(ctx)
-	serviceCacheKey := fmt.Sprintf("budget-%s", region)
-	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
-		return cachedData.(*session), nil
-	}
-
-	// get oci config info
-	ociConfig := GetConfig(d.Connection)
-
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
-	if err != nil {
-		logger.Error("budgetService", "getProvider.Error", err)
-		return nil, err
-	}
-
-	client, err := budget.NewBudgetClientWithConfigurationProvider(provider)
-	if err != nil {
-		return nil, err
-	}
-
-	tenantID, err := provider.TenancyOCID()
-	if err != nil {
-		return nil, err
-	}
-
-	sess := &session{
-		TenancyID:    tenantID,
-		BudgetClient: client,
-	}
-
-	// save session in cache
-	d.ConnectionManager.Cache.Set(serviceCacheKey, sess)
-
-	return sess, nil
-}
-
-// monitoringService returns the service client for OCI Monitoring Service
-func monitoringService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
-	logger := plugin.Logger(ctx)
-	serviceCacheKey := fmt.Sprintf("monitoring-%s", "region")
-	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
-		return cachedData.(*session), nil
-	}
-
-	// get oci config info
-	ociConfig := GetConfig(d.Connection)
-
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
-	if err != nil {
-		logger.Error("monitoringService", "getProvider.Error", err)
-		return nil, err
-	}
-
-	client, err := monitoring.NewMonitoringClientWithConfigurationProvider(provider)
-	if err != nil {
-		return nil, err
-	}
-
-	tenantID, err := provider.TenancyOCID()
-	if err != nil {
-		return nil, err
-	}
-
-	sess := &session{
-		TenancyID:        tenantID,
-		MonitoringClient: client,
-	}
-
-	// save session in cache
-	d.ConnectionManager.Cache.Set(serviceCacheKey, sess)
-
-	return sess, nil
+	return getServiceSession(ctx, d, notificationDataPlaneServiceClient, region)
 }
 
-// mySQLChannelService returns the service client for OCI MySQL Channel Service
-func mySQLChannelService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
-	logger := plugin.Logger(ctx)
-	serviceCacheKey := fmt.Sprintf("mysqlchannel-%s", region)
-	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
-		return cachedData.(*session), nil
-	}
-
-	// get oci config info
-	ociConfig := GetConfig(d.Connection)
-
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
-	if err != nil {
-		logger.Error("mySQLChannelService", "getProvider.Error", err)
-		return nil, err
-	}
-
-	client, err := mysql.NewChannelsClientWithConfigurationProvider(provider)
-	if err != nil {
-		return nil, err
-	}
-
-	tenantID, err := provider.TenancyOCID()
-	if err != nil {
-		return nil, err
-	}
-
-	sess := &session{
-		TenancyID:          tenantID,
-		MySQLChannelClient: client,
-	}
-
-	// save session in cache
-	d.ConnectionManager.Cache.Set(serviceCacheKey, sess)
-
-	return sess, nil
-}
-
-// mySqlDBSystemService returns the service client for OCI MySQL DbSystem Service
-func mySQLDBSystemService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
-	logger := plugin.Logger(ctx)
-	serviceCacheKey := fmt.Sprintf("mysqldbsystem-%s", region)
-	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
-		return cachedData.(*session), nil
-	}
-
-	// get oci config info
-	ociConfig := GetConfig(d.Connection)
-
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
-	if err != nil {
-		logger.Error("mySQLDBSystem", "getProvider.Error", err)
-		return nil, err
-	}
-
-	client, err := mysql.NewDbSystemClientWithConfigurationProvider(provider)
-	if err != nil {
-		return nil, err
-	}
-
-	tenantID, err := provider.TenancyOCID()
-	if err != nil {
-		return nil, err
-	}
-
-	sess := &session{
-		TenancyID:           tenantID,
-		MySQLDBSystemClient: client,
-	}
-
-	// save session in cache
-	d.ConnectionManager.Cache.Set(serviceCacheKey, sess)
-
-	return sess, nil
-}
-
-// noSQLDatabaseService returns the service client for OCI NoSQL Database Service
-func noSQLDatabaseService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
-	logger := plugin.Logger(ctx)
-	serviceCacheKey := fmt.Sprintf("nosql-%s", region)
-	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
-		return cachedData.(*session), nil
-	}
-
-	// get oci config info
-	ociConfig := GetConfig(d.Connection)
-
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
-	if err != nil {
-		logger.Error("noSQLDatabaseService", "getProvider.Error", err)
-		return nil, err
-	}
-
-	client, err := nosql.NewNosqlClientWithConfigurationProvider(provider)
-	if err != nil {
-		return nil, err
-	}
-
-	tenantID, err := provider.TenancyOCID()
-	if err != nil {
-		return nil, err
-	}
-
-	sess := &session{
-		TenancyID:   tenantID,
-		NoSQLClient: client,
-	}
-
-	// save session in cache
-	d.ConnectionManager.Cache.Set(serviceCacheKey, sess)
-
-	return sess, nil
-}
-
-// mySQLBackupService returns the service client for OCI MySQL Backup Service
-func mySQLBackupService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
-	logger := plugin.Logger(ctx)
-	serviceCacheKey := fmt.Sprintf("mysqlbackup-%s", region)
-	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
-		return cachedData.(*session), nil
-	}
-
-	// get oci config info
-	ociConfig := GetConfig(d.Connection)
-
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
-	if err != nil {
-		logger.Error("mySQLBackupService", "getProvider.Error", err)
-		return nil, err
-	}
-
-	client, err := mysql.NewDbBackupsClientWithConfigurationProvider(provider)
-	if err != nil {
-		return nil, err
-	}
-
-	tenantID, err := provider.TenancyOCID()
-	if err != nil {
-		return nil, err
-	}
-
-	sess := &session{
-		TenancyID:         tenantID,
-		MySQLBackupClient: client,
-	}
-
-	// save session in cache
-	d.ConnectionManager.Cache.Set(serviceCacheKey, sess)
-
-	return sess, nil
-}
-
-// mySQLConfigurationService returns the service client for OCI MySQL Configuration Service
-func mySQLConfigurationService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
-	logger := plugin.Logger(ctx)
-	serviceCacheKey := fmt.Sprintf("mysqlconfiguration-%s", region)
-	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
-		return cachedData.(*session), nil
-	}
-
-	// get oci config info
-	ociConfig := GetConfig(d.Connection)
-
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
-	if err != nil {
-		logger.Error("mySQLBackupService", "getProvider.Error", err)
-		return nil, err
-	}
-
-	client, err := mysql.NewMysqlaasClientWithConfigurationProvider(provider)
-	if err != nil {
-		return nil, err
-	}
+// coreComputeService returns the service client for OCI Core Compute service
+func coreComputeService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
+	return getServiceSession(ctx, d, computeServiceClient, region)
+}
 
-	tenantID, err := provider.TenancyOCID()
-	if err != nil {
-		return nil, err
-	}
+// coreVirtualNetworkService returns the service client for OCI Core VirtualNetwork Service
+func coreVirtualNetworkService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
+	return getServiceSession(ctx, d, virtualNetworkServiceClient, region)
+}
 
-	sess := &session{
-		TenancyID:                tenantID,
-		MySQLConfigurationClient: client,
-	}
+// cloudGuardService returns the service client for OCI Cloud Guard Service
+func cloudGuardService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
+	return getServiceSession(ctx, d, cloudGuardServiceClient, region)
+}
 
-	// save session in cache
-	d.ConnectionManager.Cache.Set(serviceCacheKey, sess)
+// dnsService returns the service client for OCI DNS Service
+func dnsService(ctx context.Context, d *plugin.QueryData) (*session, error) {
+	return getServiceSession(ctx, d, dnsServiceClient, "")
+}
 
-	return sess, nil
+// databaseService returns the service client for OCI Database Service
+func databaseService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
+	return getServiceSession(ctx, d, databaseServiceClient, region)
 }
 
-// networkLoadBalancerService returns the service client for OCI Network Load Balancer service
-func networkLoadBalancerService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
-	logger := plugin.Logger(ctx)
-	serviceCacheKey := fmt.Sprintf("networkloadbalancer-%s", region)
-	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
-		return cachedData.(*session), nil
-	}
+// budgetService returns the service client for OCI budget Service
+func budgetService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
+	return getServiceSession(ctx, d, budgetServiceClient, region)
+}
 
-	// get oci config info
-	ociConfig := GetConfig(d.Connection)
+// monitoringService returns the service client for OCI Monitoring Service
+func monitoringService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
+	return getServiceSession(ctx, d, monitoringServiceClient, region)
+}
 
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
-	if err != nil {
-		logger.Error("networkLoadBalancerService", "getProvider.Error", err)
-		return nil, err
-	}
+// mySQLChannelService returns the service client for OCI MySQL Channel Service
+func mySQLChannelService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
+	return getServiceSession(ctx, d, mysqlChannelServiceClient, region)
+}
 
-	client, err := networkloadbalancer.NewNetworkLoadBalancerClientWithConfigurationProvider(provider)
-	if err != nil {
-		return nil, err
-	}
+// mySqlDBSystemService returns the service client for OCI MySQL DbSystem Service
+func mySQLDBSystemService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
+	return getServiceSession(ctx, d, mysqlDbSystemServiceClient, region)
+}
 
-	tenantID, err := provider.TenancyOCID()
-	if err != nil {
-		return nil, err
-	}
+// noSQLDatabaseService returns the service client for OCI NoSQL Database Service
+func noSQLDatabaseService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
+	return getServiceSession(ctx, d, nosqlServiceClient, region)
+}
 
-	sess := &session{
-		TenancyID:                 tenantID,
-		NetworkLoadBalancerClient: client,
-	}
+// mySQLBackupService returns the service client for OCI MySQL Backup Service
+func mySQLBackupService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
+	return getServiceSession(ctx, d, mysqlBackupServiceClient, region)
+}
 
-	// save session in cache
-	d.ConnectionManager.Cache.Set(serviceCacheKey, sess)
+// mySQLConfigurationService returns the service client for OCI MySQL Configuration Service
+func mySQLConfigurationService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
+	return getServiceSession(ctx, d, mysqlConfigurationServiceClient, region)
+}
 
-	return sess, nil
+// networkLoadBalancerService returns the service client for OCI Network Load Balancer service
+func networkLoadBalancerService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
+	return getServiceSession(ctx, d, networkLoadBalancerServiceClient, region)
 }
 
 // resourceSearchService returns the service client for OCI Resource Search Service
 func resourceSearchService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
-	logger := plugin.Logger(ctx)
-	serviceCacheKey := fmt.Sprintf("resourcesearch-%s", region)
-	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
-		return cachedData.(*session), nil
-	}
-
-	// get oci config info
-	ociConfig := GetConfig(d.Connection)
-
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
-	if err != nil {
-		logger.Error("resourceSearchService", "getProvider.Error", err)
-		return nil, err
-	}
+	return getServiceSession(ctx, d, resourceSearchServiceClient, region)
+}
 
-	client, err := resourcesearch.NewResourceSearchClientWithConfigurationProvider(provider)
-	if err != nil {
-		return nil, err
-	}
+// resourceManagerService returns the service client for OCI ResourceManagerClient
+func resourceManagerService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
+	return getServiceSession(ctx, d, resourceManagerServiceClient, region)
+}
 
-	tenantId, err := provider.TenancyOCID()
-	if err != nil {
-		return nil, err
-	}
+// streamAdminService returns the service client for OCI Stream Admin Service
+func streamAdminService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
+	return getServiceSession(ctx, d, streamAdminServiceClient, region)
+}
 
-	sess := &session{
-		TenancyID:            tenantId,
-		ResourceSearchClient: client,
-	}
+// vaultService returns the service client for OCI Vault Service
+func vaultService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
+	return getServiceSession(ctx, d, vaultServiceClient, region)
+}
 
-	// save session in cache
-	d.ConnectionManager.Cache.Set(serviceCacheKey, sess)
+// analyticsService returns the service client for OCI Analytics service
+func analyticsService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
+	return getServiceSession(ctx, d, analyticsServiceClient, region)
+}
 
-	return sess, nil
+//// SERVICE CLIENTS
+
+var apiGatewayServiceClient = serviceClient[apigateway.ApiGatewayClient]{
+	name:      "api_gateway",
+	newClient: apigateway.NewApiGatewayClientWithConfigurationProvider,
+	baseClient: func(client *apigateway.ApiGatewayClient) *oci_common.BaseClient {
+		return &client.BaseClient
+	},
+	setClient: func(sess *session, client apigateway.ApiGatewayClient) {
+		sess.ApiGatewayClient = client
+	},
 }
 
-func resourceManagerService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
-	logger := plugin.Logger(ctx)
-	serviceCacheKey := fmt.Sprintf("resourcemanager-%s", region)
-	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
-		return cachedData.(*session), nil
-	}
+var auditServiceClient = serviceClient[audit.AuditClient]{
+	name:      "audit",
+	newClient: audit.NewAuditClientWithConfigurationProvider,
+	baseClient: func(client *audit.AuditClient) *oci_common.BaseClient {
+		return &client.BaseClient
+	},
+	setClient: func(sess *session, client audit.AuditClient) {
+		sess.AuditClient = client
+	},
+}
 
-	// get oci config info
-	ociConfig := GetConfig(d.Connection)
+var autoScalingServiceClient = serviceClient[autoscaling.AutoScalingClient]{
+	name:      "auto_scaling",
+	newClient: autoscaling.NewAutoScalingClientWithConfigurationProvider,
+	baseClient: func(client *autoscaling.AutoScalingClient) *oci_common.BaseClient {
+		return &client.BaseClient
+	},
+	setClient: func(sess *session, client autoscaling.AutoScalingClient) {
+		sess.AutoScalingClient = client
+	},
+}
 
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
-	if err != nil {
-		logger.Error("resourceManagerService", "getProvider.Error", err)
-		return nil, err
-	}
+var identityServiceClient = serviceClient[identity.IdentityClient]{
+	name:      "identity",
+	newClient: identity.NewIdentityClientWithConfigurationProvider,
+	baseClient: func(client *identity.IdentityClient) *oci_common.BaseClient {
+		return &client.BaseClient
+	},
+	setClient: func(sess *session, client identity.IdentityClient) {
+		sess.IdentityClient = client
+	},
+}
 
-	client, err := resourcemanager.NewResourceManagerClientWithConfigurationProvider(provider)
-	if err != nil {
-		return nil, err
-	}
+var loggingManagementServiceClient = serviceClient[logging.LoggingManagementClient]{
+	name:      "logging_management",
+	newClient: logging.NewLoggingManagementClientWithConfigurationProvider,
+	baseClient: func(client *logging.LoggingManagementClient) *oci_common.BaseClient {
+		return &client.BaseClient
+	},
+	setClient: func(sess *session, client logging.LoggingManagementClient) {
+		sess.LoggingManagementClient = client
+	},
+}
 
-	tenantId, err := provider.TenancyOCID()
-	if err != nil {
-		return nil, err
-	}
+var blockStorageServiceClient = serviceClient[core.BlockstorageClient]{
+	name:      "block_storage",
+	newClient: core.NewBlockstorageClientWithConfigurationProvider,
+	baseClient: func(client *core.BlockstorageClient) *oci_common.BaseClient {
+		return &client.BaseClient
+	},
+	setClient: func(sess *session, client core.BlockstorageClient) {
+		sess.BlockstorageClient = client
+	},
+}
 
-	sess := &session{
-		TenancyID:             tenantId,
-		ResourceManagerClient: client,
-	}
+var containerEngineServiceClient = serviceClient[containerengine.ContainerEngineClient]{
+	name:      "container_engine",
+	newClient: containerengine.NewContainerEngineClientWithConfigurationProvider,
+	baseClient: func(client *containerengine.ContainerEngineClient) *oci_common.BaseClient {
+		return &client.BaseClient
+	},
+	setClient: func(sess *session, client containerengine.ContainerEngineClient) {
+		sess.ContainerEngineClient = client
+	},
+}
 
-	// save session in cache
-	d.ConnectionManager.Cache.Set(serviceCacheKey, sess)
+var eventsServiceClient = serviceClient[events.EventsClient]{
+	name:      "events",
+	newClient: events.NewEventsClientWithConfigurationProvider,
+	baseClient: func(client *events.EventsClient) *oci_common.BaseClient {
+		return &client.BaseClient
+	},
+	setClient: func(sess *session, client events.EventsClient) {
+		sess.EventsClient = client
+	},
+}
 
-	return sess, nil
+var fileStorageServiceClient = serviceClient[filestorage.FileStorageClient]{
+	name:      "file_storage",
+	newClient: filestorage.NewFileStorageClientWithConfigurationProvider,
+	baseClient: func(client *filestorage.FileStorageClient) *oci_common.BaseClient {
+		return &client.BaseClient
+	},
+	setClient: func(sess *session, client filestorage.FileStorageClient) {
+		sess.FileStorageClient = client
+	},
 }
 
-// streamAdminService returns the service client for OCI Stream Admin Service
-func streamAdminService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
-	logger := plugin.Logger(ctx)
-	serviceCacheKey := fmt.Sprintf("streamadmin-%s", region)
-	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
-		return cachedData.(*session), nil
-	}
+var functionsManagementServiceClient = serviceClient[functions.FunctionsManagementClient]{
+	name:      "functions_management",
+	newClient: functions.NewFunctionsManagementClientWithConfigurationProvider,
+	baseClient: func(client *functions.FunctionsManagementClient) *oci_common.BaseClient {
+		return &client.BaseClient
+	},
+	setClient: func(sess *session, client functions.FunctionsManagementClient) {
+		sess.FunctionsManagementClient = client
+	},
+}
 
-	// get oci config info
-	ociConfig := GetConfig(d.Connection)
+var kmsManagementServiceClient = serviceClient[keymanagement.KmsManagementClient]{
+	name: "kms_management",
+	newClient: func(provider oci_common.ConfigurationProvider) (keymanagement.KmsManagementClient, error) {
+		return keymanagement.NewKmsManagementClientWithConfigurationProvider(provider, "")
+	},
+	baseClient: func(client *keymanagement.KmsManagementClient) *oci_common.BaseClient {
+		return &client.BaseClient
+	},
+	setClient: func(sess *session, client keymanagement.KmsManagementClient) {
+		sess.KmsManagementClient = client
+	},
+}
 
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
-	if err != nil {
-		logger.Error("resourceSearchService", "getProvider.Error", err)
-		return nil, err
-	}
+var kmsVaultServiceClient = serviceClient[keymanagement.KmsVaultClient]{
+	name:      "kms_vault",
+	newClient: keymanagement.NewKmsVaultClientWithConfigurationProvider,
+	baseClient: func(client *keymanagement.KmsVaultClient) *oci_common.BaseClient {
+		return &client.BaseClient
+	},
+	setClient: func(sess *session, client keymanagement.KmsVaultClient) {
+		sess.KmsVaultClient = client
+	},
+}
 
-	client, err := streaming.NewStreamAdminClientWithConfigurationProvider(provider)
-	if err != nil {
-		return nil, err
-	}
+var loadBalancerServiceClient = serviceClient[loadbalancer.LoadBalancerClient]{
+	name:      "load_balancer",
+	newClient: loadbalancer.NewLoadBalancerClientWithConfigurationProvider,
+	baseClient: func(client *loadbalancer.LoadBalancerClient) *oci_common.BaseClient {
+		return &client.BaseClient
+	},
+	setClient: func(sess *session, client loadbalancer.LoadBalancerClient) {
+		sess.LoadBalancerClient = client
+	},
+}
 
-	tenantId, err := provider.TenancyOCID()
-	if err != nil {
-		return nil, err
-	}
+var objectStorageServiceClient = serviceClient[objectstorage.ObjectStorageClient]{
+	name:      "object_storage",
+	newClient: objectstorage.NewObjectStorageClientWithConfigurationProvider,
+	baseClient: func(client *objectstorage.ObjectStorageClient) *oci_common.BaseClient {
+		return &client.BaseClient
+	},
+	setClient: func(sess *session, client objectstorage.ObjectStorageClient) {
+		sess.ObjectStorageClient = client
+	},
+}
 
-	sess := &session{
-		TenancyID:         tenantId,
-		StreamAdminClient: client,
-	}
+var notificationControlPlaneServiceClient = serviceClient[ons.NotificationControlPlaneClient]{
+	name:      "notification_control_plane",
+	newClient: ons.NewNotificationControlPlaneClientWithConfigurationProvider,
+	baseClient: func(client *ons.NotificationControlPlaneClient) *oci_common.BaseClient {
+		return &client.BaseClient
+	},
+	setClient: func(sess *session, client ons.NotificationControlPlaneClient) {
+		sess.NotificationControlPlaneClient = client
+	},
+}
 
-	// save session in cache
-	d.ConnectionManager.Cache.Set(serviceCacheKey, sess)
+var notificationDataPlaneServiceClient = serviceClient[ons.NotificationDataPlaneClient]{
+	name:      "notification_data_plane",
+	newClient: ons.NewNotificationDataPlaneClientWithConfigurationProvider,
+	baseClient: func(client *ons.NotificationDataPlaneClient) *oci_common.BaseClient {
+		return &client.BaseClient
+	},
+	setClient: func(sess *session, client ons.NotificationDataPlaneClient) {
+		sess.NotificationDataPlaneClient = client
+	},
+}
 
-	return sess, nil
+var computeServiceClient = serviceClient[core.ComputeClient]{
+	name:      "compute",
+	newClient: core.NewComputeClientWithConfigurationProvider,
+	baseClient: func(client *core.ComputeClient) *oci_common.BaseClient {
+		return &client.BaseClient
+	},
+	setClient: func(sess *session, client core.ComputeClient) {
+		sess.ComputeClient = client
+	},
 }
 
-// vaultService returns the service client for OCI Vault Service
-func vaultService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
-	logger := plugin.Logger(ctx)
-	serviceCacheKey := fmt.Sprintf("vaultService-%s", region)
-	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
-		return cachedData.(*session), nil
-	}
+var virtualNetworkServiceClient = serviceClient[core.VirtualNetworkClient]{
+	name:      "virtual_network",
+	newClient: core.NewVirtualNetworkClientWithConfigurationProvider,
+	baseClient: func(client *core.VirtualNetworkClient) *oci_common.BaseClient {
+		return &client.BaseClient
+	},
+	setClient: func(sess *session, client core.VirtualNetworkClient) {
+		sess.VirtualNetworkClient = client
+	},
+}
 
-	// get oci config info
-	ociConfig := GetConfig(d.Connection)
+var cloudGuardServiceClient = serviceClient[cloudguard.CloudGuardClient]{
+	name:      "cloud_guard",
+	newClient: cloudguard.NewCloudGuardClientWithConfigurationProvider,
+	baseClient: func(client *cloudguard.CloudGuardClient) *oci_common.BaseClient {
+		return &client.BaseClient
+	},
+	setClient: func(sess *session, client cloudguard.CloudGuardClient) {
+		sess.CloudGuardClient = client
+	},
+}
 
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
-	if err != nil {
-		logger.Error("vaultService", "getProvider.Error", err)
-		return nil, err
-	}
+var dnsServiceClient = serviceClient[dns.DnsClient]{
+	name:      "dns",
+	newClient: dns.NewDnsClientWithConfigurationProvider,
+	baseClient: func(client *dns.DnsClient) *oci_common.BaseClient {
+		return &client.BaseClient
+	},
+	setClient: func(sess *session, client dns.DnsClient) {
+		sess.DnsClient = client
+	},
+}
 
-	client, err := vault.NewVaultsClientWithConfigurationProvider(provider)
-	if err != nil {
-		return nil, err
-	}
+var databaseServiceClient = serviceClient[database.DatabaseClient]{
+	name:      "database",
+	newClient: database.NewDatabaseClientWithConfigurationProvider,
+	baseClient: func(client *database.DatabaseClient) *oci_common.BaseClient {
+		return &client.BaseClient
+	},
+	setClient: func(sess *session, client database.DatabaseClient) {
+		sess.DatabaseClient = client
+	},
+}
 
-	tenantId, err := provider.TenancyOCID()
-	if err != nil {
-		return nil, err
-	}
+var budgetServiceClient = serviceClient[budget.BudgetClient]{
+	name:      "budget",
+	newClient: budget.NewBudgetClientWithConfigurationProvider,
+	baseClient: func(client *budget.BudgetClient) *oci_common.BaseClient {
+		return &client.BaseClient
+	},
+	setClient: func(sess *session, client budget.BudgetClient) {
+		sess.BudgetClient = client
+	},
+}
 
-	sess := &session{
-		TenancyID:   tenantId,
-		VaultClient: client,
-	}
+var monitoringServiceClient = serviceClient[monitoring.MonitoringClient]{
+	name:      "monitoring",
+	newClient: monitoring.NewMonitoringClientWithConfigurationProvider,
+	baseClient: func(client *monitoring.MonitoringClient) *oci_common.BaseClient {
+		return &client.BaseClient
+	},
+	setClient: func(sess *session, client monitoring.MonitoringClient) {
+		sess.MonitoringClient = client
+	},
+}
 
-	// save session in cache
-	d.ConnectionManager.Cache.Set(serviceCacheKey, sess)
+var mysqlChannelServiceClient = serviceClient[mysql.ChannelsClient]{
+	name:      "mysql_channel",
+	newClient: mysql.NewChannelsClientWithConfigurationProvider,
+	baseClient: func(client *mysql.ChannelsClient) *oci_common.BaseClient {
+		return &client.BaseClient
+	},
+	setClient: func(sess *session, client mysql.ChannelsClient) {
+		sess.MySQLChannelClient = client
+	},
+}
 
-	return sess, nil
+var mysqlDbSystemServiceClient = serviceClient[mysql.DbSystemClient]{
+	name:      "mysql_db_system",
+	newClient: mysql.NewDbSystemClientWithConfigurationProvider,
+	baseClient: func(client *mysql.DbSystemClient) *oci_common.BaseClient {
+		return &client.BaseClient
+	},
+	setClient: func(sess *session, client mysql.DbSystemClient) {
+		sess.MySQLDBSystemClient = client
+	},
 }
 
-// analyticsService returns the service client for OCI Analytics service
-func analyticsService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
-	logger := plugin.Logger(ctx)
+var nosqlServiceClient = serviceClient[nosql.NosqlClient]{
+	name:      "nosql",
+	newClient: nosql.NewNosqlClientWithConfigurationProvider,
+	baseClient: func(client *nosql.NosqlClient) *oci_common.BaseClient {
+		return &client.BaseClient
+	},
+	setClient: func(sess *session, client nosql.NosqlClient) {
+		sess.NoSQLClient = client
+	},
+}
 
-	// have we already created and cached the service?
-	serviceCacheKey := fmt.Sprintf("analytics-%s", region)
-	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
-		return cachedData.(*session), nil
-	}
+var mysqlBackupServiceClient = serviceClient[mysql.DbBackupsClient]{
+	name:      "mysql_backup",
+	newClient: mysql.NewDbBackupsClientWithConfigurationProvider,
+	baseClient: func(client *mysql.DbBackupsClient) *oci_common.BaseClient {
+		return &client.BaseClient
+	},
+	setClient: func(sess *session, client mysql.DbBackupsClient) {
+		sess.MySQLBackupClient = client
+	},
+}
 
-	// get oci config info from steampipe connection
-	ociConfig := GetConfig(d.Connection)
+var mysqlConfigurationServiceClient = serviceClient[mysql.MysqlaasClient]{
+	name:      "mysql_configuration",
+	newClient: mysql.NewMysqlaasClientWithConfigurationProvider,
+	baseClient: func(client *mysql.MysqlaasClient) *oci_common.BaseClient {
+		return &client.BaseClient
+	},
+	setClient: func(sess *session, client mysql.MysqlaasClient) {
+		sess.MySQLConfigurationClient = client
+	},
+}
 
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
-	if err != nil {
-		logger.Error("analyticsService", "getProvider.Error", err)
-		return nil, err
-	}
+var networkLoadBalancerServiceClient = serviceClient[networkloadbalancer.NetworkLoadBalancerClient]{
+	name:      "network_load_balancer",
+	newClient: networkloadbalancer.NewNetworkLoadBalancerClientWithConfigurationProvider,
+	baseClient: func(client *networkloadbalancer.NetworkLoadBalancerClient) *oci_common.BaseClient {
+		return &client.BaseClient
+	},
+	setClient: func(sess *session, client networkloadbalancer.NetworkLoadBalancerClient) {
+		sess.NetworkLoadBalancerClient = client
+	},
+}
 
-	// get analytics service client
-	client, err := analytics.NewAnalyticsClientWithConfigurationProvider(provider)
-	if err != nil {
-		return nil, err
-	}
+var resourceSearchServiceClient = serviceClient[resourcesearch.ResourceSearchClient]{
+	name:      "resource_search",
+	newClient: resourcesearch.NewResourceSearchClientWithConfigurationProvider,
+	baseClient: func(client *resourcesearch.ResourceSearchClient) *oci_common.BaseClient {
+		return &client.BaseClient
+	},
+	setClient: func(sess *session, client resourcesearch.ResourceSearchClient) {
+		sess.ResourceSearchClient = client
+	},
+}
 
-	// get tenant ocid from provider
-	tenantId, err := provider.TenancyOCID()
-	if err != nil {
-		return nil, err
-	}
+var resourceManagerServiceClient = serviceClient[resourcemanager.ResourceManagerClient]{
+	name:      "resource_manager",
+	newClient: resourcemanager.NewResourceManagerClientWithConfigurationProvider,
+	baseClient: func(client *resourcemanager.ResourceManagerClient) *oci_common.BaseClient {
+		return &client.BaseClient
+	},
+	setClient: func(sess *session, client resourcemanager.ResourceManagerClient) {
+		sess.ResourceManagerClient = client
+	},
+}
 
-	sess := &session{
-		TenancyID:       tenantId,
-		AnalyticsClient: client,
-	}
+var streamAdminServiceClient = serviceClient[streaming.StreamAdminClient]{
+	name:      "stream_admin",
+	newClient: streaming.NewStreamAdminClientWithConfigurationProvider,
+	baseClient: func(client *streaming.StreamAdminClient) *oci_common.BaseClient {
+		return &client.BaseClient
+	},
+	setClient: func(sess *session, client streaming.StreamAdminClient) {
+		sess.StreamAdminClient = client
+	},
+}
 
-	// save session in cache
-	d.ConnectionManager.Cache.Set(serviceCacheKey, sess)
+var vaultServiceClient = serviceClient[vault.VaultsClient]{
+	name:      "vault",
+	newClient: vault.NewVaultsClientWithConfigurationProvider,
+	baseClient: func(client *vault.VaultsClient) *oci_common.BaseClient {
+		return &client.BaseClient
+	},
+	setClient: func(sess *session, client vault.VaultsClient) {
+		sess.VaultClient = client
+	},
+}
 
-	return sess, nil
+var analyticsServiceClient = serviceClient[analytics.AnalyticsClient]{
+	name:      "analytics",
+	newClient: analytics.NewAnalyticsClientWithConfigurationProvider,
+	baseClient: func(client *analytics.AnalyticsClient) *oci_common.BaseClient {
+		return &client.BaseClient
+	},
+	setClient: func(sess *session, client analytics.AnalyticsClient) {
+		sess.AnalyticsClient = client
+	},
 }
 
 // get the configuration provider for the OCI plugin connection to intract with API's
 func getProvider(_ context.Context, d *connection.Manager, region string, config ociConfig) (oci_common.ConfigurationProvider, error) {
 
-	cacheKey := "getProvider"
-	// if provider is already cached, return it
-	if cachedData, ok := d.Cache.Get(cacheKey); ok {
-		return cachedData.(oci_common.ConfigurationProvider), nil
-	}
-
-	if region == "" && config.Regions != nil && len(config.Regions) > 0 {
-		region = config.Regions[0]
+	// default to the first configured region which is not a wildcard pattern
+	if region == "" {
+		for _, configRegion := range config.Regions {
+			if !isRegionPattern(configRegion) {
+				region = configRegion
+				break
+			}
+		}
 	}
 
 	if region == "" {
@@ -1468,20 +668,14 @@ func getProvider(_ context.Context, d *connection.Manager, region string, config
 		authType = *config.Auth
 	}
 
-	if authType == "SecurityToken" {
-		return getProviderForSecurityToken(region, config)
-	}
-
-	if authType == "InstancePrincipal" {
-		return getProviderForInstancePrincipal(region)
-	}
-
-	if authType == "ApiKey" {
-		return getProviderForAPIkey(region, config)
+	// providers are bound to a region, and to the tenancy selected by the profile
+	cacheKey := fmt.Sprintf("getProvider-%s-%s-%s-%s", authType, types.SafeString(config.ConfigPath), types.SafeString(config.Profile), region)
+	// if provider is already cached, return it
+	if cachedData, ok := d.Cache.Get(cacheKey); ok {
+		return cachedData.(oci_common.ConfigurationProvider), nil
 	}
 
-	regionInfo := oci_common.NewRawConfigurationProvider("", "", region, "", "", nil)
-	provider, err := oci_common.ComposingConfigurationProvider([]oci_common.ConfigurationProvider{regionInfo, oci_common.DefaultConfigProvider()})
+	provider, err := getProviderForAuthType(d, cacheKey, authType, region, config)
 	if err != nil {
 		return nil, err
 	}
@@ -1492,6 +686,30 @@ func getProvider(_ context.Context, d *connection.Manager, region string, config
 	return provider, nil
 }
 
+func getProviderForAuthType(d *connection.Manager, cacheKey string, authType string, region string, config ociConfig) (oci_common.ConfigurationProvider, error) {
+	switch authType {
+	case "SecurityToken":
+		// an expired token drops the cached provider and its sessions, so a changed config file is read again
+		return getProviderForSecurityToken(region, config, func(sessionCacheKeys []string) {
+			d.Cache.Delete(cacheKey)
+			for _, key := range sessionCacheKeys {
+				d.Cache.Delete(key)
+			}
+		})
+	case "InstancePrincipal":
+		return getProviderForInstancePrincipal(region)
+	case "ResourcePrincipal":
+		return getProviderForResourcePrincipal(region)
+	case "OkeWorkloadIdentity":
+		return getProviderForOkeWorkloadIdentity(region)
+	case "ApiKey":
+		return getProviderForAPIkey(region, config)
+	}
+
+	regionInfo := oci_common.NewRawConfigurationProvider("", "", region, "", "", nil)
+	return oci_common.ComposingConfigurationProvider([]oci_common.ConfigurationProvider{regionInfo, oci_common.DefaultConfigProvider()})
+}
+
 /*
 	#  Configure the Oracle Cloud Infrastructure provider with an API Key / or a profile
 	connection "oci" {
@@ -1568,7 +786,7 @@ func getProviderForAPIkey(region string, config ociConfig) (oci_common.Configura
 		config_file_profile= "config_file_profile"
 	}
 */
-func getProviderForSecurityToken(region string, config ociConfig) (oci_common.ConfigurationProvider, error) {
+func getProviderForSecurityToken(region string, config ociConfig, onExpired func(sessionCacheKeys []string)) (oci_common.ConfigurationProvider, error) {
 	regionInfo := oci_common.NewRawConfigurationProvider("", "", region, "", "", nil)
 
 	if config.Profile == nil {
@@ -1576,19 +794,32 @@ func getProviderForSecurityToken(region string, config ociConfig) (oci_common.Co
 	}
 
 	profileString := *config.Profile
-	defaultPath := path.Join(getHomeFolder(), ".oci", "config")
-	if err := checkProfile(profileString, defaultPath); err != nil {
+	configPath := path.Join(getHomeFolder(), ".oci", "config")
+	if config.ConfigPath != nil {
+		configPath = expandPath(*config.ConfigPath)
+	}
+	if err := checkProfile(profileString, configPath); err != nil {
 		return nil, err
 	}
 
-	securityTokenBasedAuthConfigProvider := oci_common.CustomProfileConfigProvider(defaultPath, profileString)
+	tokenPath, err := getProfileSetting(configPath, profileString, "security_token_file")
+	if err != nil {
+		return nil, fmt.Errorf("security token is invalid: %s", err.Error())
+	}
+
+	securityTokenBasedAuthConfigProvider := oci_common.CustomProfileConfigProvider(configPath, profileString)
+	composedProvider, err := oci_common.ComposingConfigurationProvider([]oci_common.ConfigurationProvider{regionInfo, securityTokenBasedAuthConfigProvider})
+	if err != nil {
+		return nil, err
+	}
 
-	keyId, err := securityTokenBasedAuthConfigProvider.KeyID()
-	if err != nil || !strings.HasPrefix(keyId, "ST$") {
-		return nil, fmt.Errorf("security token is invalid")
+	// the provider re-reads the token file when it is refreshed, so it is shared by all sessions of the region
+	provider, err := newSecurityTokenProvider(composedProvider, profileString, tokenPath, onExpired)
+	if err != nil {
+		return nil, err
 	}
 
-	return oci_common.ComposingConfigurationProvider([]oci_common.ConfigurationProvider{regionInfo, securityTokenBasedAuthConfigProvider})
+	return provider, nil
 }
 
 /*
@@ -1600,26 +831,81 @@ func getProviderForSecurityToken(region string, config ociConfig) (oci_common.Co
 	}
 */
 func getProviderForInstancePrincipal(region string) (oci_common.ConfigurationProvider, error) {
+	cfg, err := oci_common_auth.InstancePrincipalConfigurationForRegionWithCustomClient(oci_common.StringToRegion(region), authClientModifier)
+	if err != nil {
+		return nil, err
+	}
 
-	// Used to modify InstancePrincipal auth clients so that `accept_local_certs` is honored for auth clients as well
-	// These clients are created implicitly by SDK, and are not modified by the buildConfigureClientFn that usually does this for the other SDK clients
-	instancePrincipalAuthClientModifier := func(client oci_common.HTTPRequestDispatcher) (oci_common.HTTPRequestDispatcher, error) {
-		if acceptLocalCerts := getEnvSettingWithBlankDefault("accept_local_certs"); acceptLocalCerts != "" {
-			if bool, err := strconv.ParseBool(acceptLocalCerts); err == nil {
-				modifiedClient := buildHttpClient()
-				modifiedClient.Transport.(*http.Transport).TLSClientConfig.InsecureSkipVerify = bool
-				return modifiedClient, nil
-			}
+	return oci_common.ComposingConfigurationProvider([]oci_common.ConfigurationProvider{cfg})
+}
+
+/*
+# Provider for Resource Principal based authentication, e.g. in OCI Functions
+	connection "oci" {
+		plugin 		= "oci"
+		auth 			= "ResourcePrincipal"
+		region 		= [ "ap-mumbai-1" ]
+	}
+*/
+func getProviderForResourcePrincipal(region string) (oci_common.ConfigurationProvider, error) {
+	// Version 1.1 resource principals exchange an instance principal token for a
+	// token of the resource in OCI_RESOURCE_PRINCIPAL_RPT_ID, or of the instance in
+	// the metadata service. The SDK builds the instance principal itself, so
+	// `accept_local_certs` is not honored for its auth client.
+	if os.Getenv(oci_common_auth.ResourcePrincipalVersionEnvVar) == oci_common_auth.ResourcePrincipalVersion1_1 {
+		cfg, err := oci_common_auth.ResourcePrincipalConfigurationProviderWithPathProvider(oci_common_auth.DefaultRptPathProvider{})
+		if err != nil {
+			return nil, err
 		}
-		return client, nil
+		regionInfo := oci_common.NewRawConfigurationProvider("", "", region, "", "", nil)
+		return oci_common.ComposingConfigurationProvider([]oci_common.ConfigurationProvider{regionInfo, cfg})
 	}
 
-	cfg, err := oci_common_auth.InstancePrincipalConfigurationForRegionWithCustomClient(oci_common.StringToRegion(region), instancePrincipalAuthClientModifier)
+	cfg, err := oci_common_auth.ResourcePrincipalConfigurationProvider()
 	if err != nil {
 		return nil, err
 	}
 
-	return oci_common.ComposingConfigurationProvider([]oci_common.ConfigurationProvider{cfg})
+	// query the connection region rather than the region the resource principal was issued in
+	regionInfo := oci_common.NewRawConfigurationProvider("", "", region, "", "", nil)
+	return oci_common.ComposingConfigurationProvider([]oci_common.ConfigurationProvider{regionInfo, cfg})
+}
+
+/*
+# Provider for OKE Workload Identity based authentication, in pods of OKE enhanced clusters
+	connection "oci" {
+		plugin 		= "oci"
+		auth 			= "OkeWorkloadIdentity"
+		region 		= [ "ap-mumbai-1" ]
+	}
+*/
+func getProviderForOkeWorkloadIdentity(region string) (oci_common.ConfigurationProvider, error) {
+	endpoint, err := getOkeProxymuxEndpoint()
+	if err != nil {
+		return nil, err
+	}
+
+	// the provider is not composed, so getServiceSession can install its request signer
+	return newOkeWorkloadIdentityProvider(region, endpoint, okeServiceAccountTokenPath, okeServiceAccountCAPath, authClientModifier)
+}
+
+// Used to modify principal auth clients so that `accept_local_certs` is honored for auth clients as well
+// These clients are created implicitly by SDK, and are not modified by the buildConfigureClientFn that usually does this for the other SDK clients
+func authClientModifier(client oci_common.HTTPRequestDispatcher) (oci_common.HTTPRequestDispatcher, error) {
+	if acceptLocalCerts := getEnvSettingWithBlankDefault("accept_local_certs"); acceptLocalCerts != "" {
+		if bool, err := strconv.ParseBool(acceptLocalCerts); err == nil {
+			// keep the settings of clients built by the plugin, e.g. a custom CA pool
+			modifiedClient, ok := client.(*http.Client)
+			if !ok || modifiedClient.Transport == nil {
+				modifiedClient = buildHttpClient()
+			}
+			if transport, ok := modifiedClient.Transport.(*http.Transport); ok {
+				transport.TLSClientConfig.InsecureSkipVerify = bool
+				return modifiedClient, nil
+			}
+		}
+	}
+	return client, nil
 }
 
 // cleans and expands the path if it contains a tilde,
@@ -1761,3 +1047,63 @@ func buildHttpClient() (httpClient *http.Client) {
 	}
 	return
 }
+
+// buildHttpClientForConfig returns the HTTP client of the service clients, with
+// the proxy, TLS and timeout settings of the connection config applied
+func buildHttpClientForConfig(config ociConfig) (*http.Client, error) {
+	httpClient := buildHttpClient()
+	transport := httpClient.Transport.(*http.Transport)
+
+	if config.ProxyUrl != nil && *config.ProxyUrl != "" {
+		proxyUrl, err := url.Parse(*config.ProxyUrl)
+		if err != nil {
+			return nil, fmt.Errorf("connection config has invalid proxy_url '%s': %s", *config.ProxyUrl, err.Error())
+		}
+		transport.Proxy = http.ProxyURL(proxyUrl)
+	}
+
+	if config.CaBundlePath != nil && *config.CaBundlePath != "" {
+		caBundle, err := os.ReadFile(expandPath(*config.CaBundlePath))
+		if err != nil {
+			return nil, fmt.Errorf("can not read CA bundle from: '%s', Error: %q", *config.CaBundlePath, err)
+		}
+		certPool, err := x509.SystemCertPool()
+		if err != nil {
+			certPool = x509.NewCertPool()
+		}
+		if !certPool.AppendCertsFromPEM(caBundle) {
+			return nil, fmt.Errorf("CA bundle '%s' does not contain any PEM encoded certificate", *config.CaBundlePath)
+		}
+		transport.TLSClientConfig.RootCAs = certPool
+	}
+
+	if config.InsecureSkipVerify != nil {
+		transport.TLSClientConfig.InsecureSkipVerify = *config.InsecureSkipVerify
+	}
+
+	// mutual TLS
+	if config.ClientCertificatePath != nil || config.ClientKeyPath != nil {
+		if config.ClientCertificatePath == nil || config.ClientKeyPath == nil {
+			return nil, fmt.Errorf("both client_certificate_path and client_key_path must be set in the connection configuration for mutual TLS")
+		}
+		certificate, err := tls.LoadX509KeyPair(expandPath(*config.ClientCertificatePath), expandPath(*config.ClientKeyPath))
+		if err != nil {
+			return nil, fmt.Errorf("can not load client certificate from: '%s', Error: %q", *config.ClientCertificatePath, err)
+		}
+		transport.TLSClientConfig.Certificates = []tls.Certificate{certificate}
+	}
+
+	if config.ConnectTimeout != nil {
+		transport.DialContext = (&net.Dialer{
+			Timeout: time.Duration(*config.ConnectTimeout) * time.Second,
+		}).DialContext
+	}
+	if config.TLSHandshakeTimeout != nil {
+		transport.TLSHandshakeTimeout = time.Duration(*config.TLSHandshakeTimeout) * time.Second
+	}
+	if config.RequestTimeout != nil {
+		httpClient.Timeout = time.Duration(*config.RequestTimeout) * time.Second
+	}
+
+	return httpClient, nil
+}
@@ -0,0 +1,169 @@
+package oci
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	oci_common "github.com/oracle/oci-go-sdk/v44/common"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+)
+
+// pluginUserAgent is appended to the user agent of every SDK client
+const pluginUserAgent = "steampipe-plugin-oci"
+
+// serviceClient describes how to build the SDK client of an OCI service and
+// where to store it in the session
+type serviceClient[T any] struct {
+	// name of the service, used in cache keys and in the connection config
+	name string
+	// the SDK client constructor
+	newClient func(provider oci_common.ConfigurationProvider) (T, error)
+	// returns the base client of an SDK client, to apply the shared client configuration
+	baseClient func(client *T) *oci_common.BaseClient
+	// stores the client in the session
+	setClient func(sess *session, client T)
+	// an explicit endpoint for the client, e.g. the management endpoint of a vault
+	endpoint string
+}
+
+// getServiceSession returns a session holding the client of the service for
+// the tenancy of the current call and the given region. Global services are
+// built with an empty region, which resolves to the default region of the
+// connection. Sessions are cached per service, tenancy, region and endpoint.
+func getServiceSession[T any](ctx context.Context, d *plugin.QueryData, service serviceClient[T], region string) (*session, error) {
+	logger := plugin.Logger(ctx)
+
+	// have we already created and cached the service?
+	serviceCacheKey := fmt.Sprintf("%s-%s-%s", service.name, getTenancyName(ctx), region)
+	if service.endpoint != "" {
+		serviceCacheKey = fmt.Sprintf("%s-%s", serviceCacheKey, service.endpoint)
+	}
+	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
+		return cachedData.(*session), nil
+	}
+
+	// get oci config info from steampipe connection
+	ociConfig, err := getTenancyConfig(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
+	if err != nil {
+		logger.Error("getServiceSession", "service", service.name, "getProvider.Error", err)
+		return nil, err
+	}
+
+	client, err := service.newClient(provider)
+	if err != nil {
+		logger.Error("getServiceSession", "service", service.name, "newClient.Error", err)
+		return nil, err
+	}
+
+	// OKE workload identity sessions sign with the key and token of the same session
+	if provider, ok := provider.(*okeWorkloadIdentityProvider); ok {
+		service.baseClient(&client).Signer = okeWorkloadIdentitySigner{provider: provider}
+	}
+
+	// global services are built in the default region of the provider
+	clientRegion, err := provider.Region()
+	if err != nil {
+		return nil, err
+	}
+
+	err = configureBaseClient(d, ociConfig, service.baseClient(&client), getTenancyName(ctx), service.name, clientRegion, service.endpoint)
+	if err != nil {
+		logger.Error("getServiceSession", "service", service.name, "configureBaseClient.Error", err)
+		return nil, err
+	}
+
+	// get tenant ocid from provider
+	tenantId, err := provider.TenancyOCID()
+	if err != nil {
+		logger.Error("getServiceSession", "service", service.name, "TenancyOCID.Error", err)
+		return nil, err
+	}
+
+	sess := &session{
+		TenancyID: tenantId,
+		Service:   service.name,
+		Region:    clientRegion,
+	}
+	service.setClient(sess, client)
+
+	// save session in cache
+	d.ConnectionManager.Cache.Set(serviceCacheKey, sess)
+
+	// sessions signing with a security token are dropped once the token expires
+	if provider, ok := provider.(*securityTokenProvider); ok {
+		provider.addSessionCacheKey(serviceCacheKey)
+	}
+
+	return sess, nil
+}
+
+// configureBaseClient applies the client configuration shared by all services
+func configureBaseClient(d *plugin.QueryData, config ociConfig, client *oci_common.BaseClient, tenancy string, service string, region string, endpoint string) error {
+	client.UserAgent = fmt.Sprintf("%s %s", client.UserAgent, pluginUserAgent)
+
+	// share the HTTP client, and its connection pool, between the service clients of the connection
+	cacheKey := "buildHttpClientForConfig"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+		client.HTTPClient = cachedData.(*http.Client)
+	} else {
+		httpClient, err := buildHttpClientForConfig(config)
+		if err != nil {
+			return err
+		}
+		d.ConnectionManager.Cache.Set(cacheKey, httpClient)
+		client.HTTPClient = httpClient
+	}
+
+	// record the traffic of the client to the cassette directory, or replay it from there
+	mode, dir, err := getCassette(config)
+	if err != nil {
+		return err
+	}
+	switch mode {
+	case cassetteModeRecord:
+		client.HTTPClient = cassetteRecorder{dispatcher: client.HTTPClient, dir: dir, service: service}
+	case cassetteModeReplay:
+		client.HTTPClient = cassetteReplayer{dir: dir, service: service}
+	}
+
+	// record the statistics of the requests sent, excluding the time spent waiting for the rate limiter
+	client.HTTPClient = apiCallRecorder{dispatcher: client.HTTPClient, connection: d.Connection.Name, tenancy: tenancy, service: service, region: region}
+
+	// the disk cache only reads its TTLs when it is used, so invalid TTLs are reported here
+	if _, err := getDiskCacheTTL(config, diskCacheCompartments); err != nil {
+		return err
+	}
+
+	// API limits apply per tenancy, so the limiter is shared by all clients of the service in the region
+	rate, err := getServiceRateLimit(config, service)
+	if err != nil {
+		return err
+	}
+	if limiter := getRateLimiter(d.Connection.Name, tenancy, service, region, rate); limiter != nil {
+		client.HTTPClient = rateLimitedDispatcher{dispatcher: client.HTTPClient, limiter: limiter}
+	}
+
+	// an explicit endpoint takes precedence over the endpoints of the connection config
+	if endpoint == "" {
+		configEndpoint, err := getServiceEndpoint(config, service, region, client.Host)
+		if err != nil {
+			return err
+		}
+		endpoint = configEndpoint
+	}
+	if endpoint != "" {
+		client.Host = endpoint
+	}
+
+	// requests which set their own retry policy take precedence
+	retryPolicy := getDefaultRetryPolicy(d.Connection)
+	client.SetCustomClientConfiguration(oci_common.CustomClientConfiguration{RetryPolicy: retryPolicy})
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package oci
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/dgraph-io/ristretto"
+	"github.com/eko/gocache/v3/cache"
+	"github.com/eko/gocache/v3/store"
+	"github.com/hashicorp/go-hclog"
+	"github.com/turbot/steampipe-plugin-oci/oci/ocitest"
+	"github.com/turbot/steampipe-plugin-sdk/v4/connection"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/context_key"
+)
+
+// newTestQueryData returns the query data of a connection with the given
+// config, backed by the same connection cache as the plugin
+func newTestQueryData(t *testing.T, config string) *plugin.QueryData {
+	t.Helper()
+	schema := &plugin.ConnectionConfigSchema{NewInstance: ConfigInstance, Schema: ConfigSchema}
+	parsed, err := schema.Parse(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ristrettoCache, err := ristretto.NewCache(&ristretto.Config{NumCounters: 1000, MaxCost: 100000, BufferItems: 64})
+	if err != nil {
+		t.Fatal(err)
+	}
+	connectionCache := connection.NewConnectionCache("oci", cache.New[any](&syncRistrettoStore{store.NewRistretto(ristrettoCache), ristrettoCache}))
+	return &plugin.QueryData{
+		ConnectionManager: connection.NewManager(connectionCache),
+		ConnectionCache:   connectionCache,
+		Connection:        &plugin.Connection{Name: "oci", Config: parsed},
+	}
+}
+
+// syncRistrettoStore waits for the buffered writes of the cache, so cached
+// values can be read right after they are set
+type syncRistrettoStore struct {
+	*store.RistrettoStore
+	cache *ristretto.Cache
+}
+
+func (s *syncRistrettoStore) Set(ctx context.Context, key any, value any, options ...store.Option) error {
+	err := s.RistrettoStore.Set(ctx, key, value, options...)
+	s.cache.Wait()
+	return err
+}
+
+// newTestContext returns a context with the logger of the plugin calls
+func newTestContext() context.Context {
+	return context.WithValue(context.Background(), context_key.Logger, hclog.NewNullLogger())
+}
+
+func TestGetServiceSessionRegions(t *testing.T) {
+	// the fake server only provides the API key of the tenancy, the clients keep their default endpoints
+	server, err := ocitest.NewServer(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer server.Close()
+	config := strings.Replace(server.Config(), fmt.Sprintf("regions      = [%q]", ocitest.Region), `regions      = ["us-ashburn-1", "eu-frankfurt-1"]`, 1)
+	d := newTestQueryData(t, config)
+
+	ctx := newTestContext()
+	for _, region := range []string{"us-ashburn-1", "eu-frankfurt-1"} {
+		session, err := coreVirtualNetworkService(ctx, d, region)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if session.Region != region {
+			t.Errorf("session region = %s, want %s", session.Region, region)
+		}
+		if host := session.VirtualNetworkClient.Host; !strings.Contains(host, region) {
+			t.Errorf("client host of region %s = %s", region, host)
+		}
+		if session.TenancyID != ocitest.TenancyOCID {
+			t.Errorf("session tenancy = %s, want %s", session.TenancyID, ocitest.TenancyOCID)
+		}
+	}
+}
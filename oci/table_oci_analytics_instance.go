@@ -48,7 +48,7 @@ func tableAnalyticsInstance(_ context.Context) *plugin.Table {
 			},
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
-		Columns: []*plugin.Column{
+		Columns: CommonColumns([]*plugin.Column{
 			{
 				Name:        "name",
 				Description: "A user-friendly name. Does not have to be unique, and it's changeable.",
@@ -152,7 +152,7 @@ func tableAnalyticsInstance(_ context.Context) *plugin.Table {
 				Description: ColumnDescriptionTags,
 				Type:        proto.ColumnType_JSON,
 				Hydrate:     getAnalyticsInstance,
-				Transform:   transform.From(analyticsInstanceTags),
+				Transform:   transform.From(commonTags),
 			},
 			{
 				Name:        "title",
@@ -160,28 +160,7 @@ func tableAnalyticsInstance(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("Name"),
 			},
-
-			// Standard OCI columns
-			{
-				Name:        "region",
-				Description: ColumnDescriptionRegion,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("Id").Transform(ociRegionName),
-			},
-			{
-				Name:        "compartment_id",
-				Description: ColumnDescriptionCompartment,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("CompartmentId"),
-			},
-			{
-				Name:        "tenant_id",
-				Description: ColumnDescriptionTenant,
-				Type:        proto.ColumnType_STRING,
-				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
-				Transform:   transform.FromValue(),
-			},
-		},
+		}),
 	}
 }
 
@@ -225,7 +204,7 @@ func listAnalyticsInstances(ctx context.Context, d *plugin.QueryData, _ *plugin.
 	for pagesLeft {
 		response, err := session.AnalyticsClient.ListAnalyticsInstances(ctx, request)
 		if err != nil {
-			return nil, err
+			return nil, wrapServiceError(err, session, "ListAnalyticsInstances")
 		}
 
 		for _, instance := range response.Items {
@@ -287,7 +266,7 @@ func getAnalyticsInstance(ctx context.Context, d *plugin.QueryData, h *plugin.Hy
 
 	response, err := session.AnalyticsClient.GetAnalyticsInstance(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetAnalyticsInstance")
 	}
 
 	return response.AnalyticsInstance, nil
@@ -295,36 +274,6 @@ func getAnalyticsInstance(ctx context.Context, d *plugin.QueryData, h *plugin.Hy
 
 //// TRANSFORM FUNCTION
 
-// Priority order for tags
-// 1. Free-form tags
-// 2. Defined Tags
-func analyticsInstanceTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
-	instance := d.HydrateItem.(analytics.AnalyticsInstance)
-
-	var tags map[string]interface{}
-
-	if instance.FreeformTags != nil {
-		tags = map[string]interface{}{}
-		for k, v := range instance.FreeformTags {
-			tags[k] = v
-		}
-	}
-
-	if instance.DefinedTags != nil {
-		if tags == nil {
-			tags = map[string]interface{}{}
-		}
-		for _, v := range instance.DefinedTags {
-			for key, value := range v {
-				tags[key] = value
-			}
-
-		}
-	}
-
-	return tags, nil
-}
-
 // Build additional filters
 func buildAnalyticsInstanceFilters(equalQuals plugin.KeyColumnEqualsQualMap) analytics.ListAnalyticsInstancesRequest {
 	request := analytics.ListAnalyticsInstancesRequest{}
@@ -40,7 +40,7 @@ func tableApiGatewayApi(_ context.Context) *plugin.Table {
 			},
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
-		Columns: []*plugin.Column{
+		Columns: CommonColumns([]*plugin.Column{
 			{
 				Name:        "id",
 				Description: "The OCID of the resource.",
@@ -100,36 +100,7 @@ func tableApiGatewayApi(_ context.Context) *plugin.Table {
 				Description: ColumnDescriptionFreefromTags,
 				Type:        proto.ColumnType_JSON,
 			},
-
-			// Standard Steampipe columns
-			{
-				Name:        "tags",
-				Description: ColumnDescriptionTags,
-				Type:        proto.ColumnType_JSON,
-				Transform:   transform.From(apiTags),
-			},
-			{
-				Name:        "title",
-				Description: ColumnDescriptionTitle,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("DisplayName"),
-			},
-
-			// Standard OCI columns
-			{
-				Name:        "compartment_id",
-				Description: ColumnDescriptionCompartment,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("CompartmentId"),
-			},
-			{
-				Name:        "tenant_id",
-				Description: ColumnDescriptionTenant,
-				Type:        proto.ColumnType_STRING,
-				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
-				Transform:   transform.FromValue(),
-			},
-		},
+		}),
 	}
 }
 
@@ -190,7 +161,7 @@ func listApiGatewayApis(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydr
 					return apigateway.ListApisResponse{}, nil
 				}
 			}
-			return nil, err
+			return nil, wrapServiceError(err, session, "ListApis")
 		}
 
 		for _, api := range response.Items {
@@ -249,7 +220,7 @@ func getApiGatewayApi(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrat
 	response, err := session.ApiGatewayClient.GetApi(ctx, request)
 	if err != nil {
 		logger.Error("getApiGatewayApi", "error_GetApi", err)
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetApi")
 	}
 
 	return response.Api, nil
@@ -257,39 +228,6 @@ func getApiGatewayApi(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrat
 
 //// TRANSFORM FUNCTION
 
-// Priority order for tags
-// 1. System Tags
-// 2. Defined Tags
-// 3. Free-form tags
-func apiTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
-	freeformTags := apiGatewayApiFreeformTags(d.HydrateItem)
-
-	var tags map[string]interface{}
-
-	if freeformTags != nil {
-		tags = map[string]interface{}{}
-		for k, v := range freeformTags {
-			tags[k] = v
-		}
-	}
-
-	definedTags := apiGatewayApiDefinedTags(d.HydrateItem)
-
-	if definedTags != nil {
-		if tags == nil {
-			tags = map[string]interface{}{}
-		}
-		for _, v := range definedTags {
-			for key, value := range v {
-				tags[key] = value
-			}
-
-		}
-	}
-
-	return tags, nil
-}
-
 func apiGatewayApiFreeformTags(item interface{}) map[string]string {
 	switch item := item.(type) {
 	case apigateway.Api:
@@ -36,7 +36,7 @@ func tableAutoScalingConfiguration(_ context.Context) *plugin.Table {
 			},
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
-		Columns: []*plugin.Column{
+		Columns: CommonColumns([]*plugin.Column{
 			{
 				Name:        "id",
 				Description: "The OCID of the autoscaling configuration.",
@@ -101,42 +101,7 @@ func tableAutoScalingConfiguration(_ context.Context) *plugin.Table {
 				Description: ColumnDescriptionFreefromTags,
 				Type:        proto.ColumnType_JSON,
 			},
-
-			// Standard Steampipe columns
-			{
-				Name:        "tags",
-				Description: ColumnDescriptionTags,
-				Type:        proto.ColumnType_JSON,
-				Transform:   transform.From(autoScalingConfigurationTags),
-			},
-			{
-				Name:        "title",
-				Description: ColumnDescriptionTitle,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("DisplayName"),
-			},
-
-			// Standard OCI columns
-			{
-				Name:        "region",
-				Description: ColumnDescriptionRegion,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("Id").Transform(ociRegionName),
-			},
-			{
-				Name:        "compartment_id",
-				Description: ColumnDescriptionCompartment,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("CompartmentId"),
-			},
-			{
-				Name:        "tenant_id",
-				Description: ColumnDescriptionTenant,
-				Type:        proto.ColumnType_STRING,
-				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
-				Transform:   transform.FromValue(),
-			},
-		},
+		}),
 	}
 }
 
@@ -186,7 +151,7 @@ func listAutoScalingConfigurations(ctx context.Context, d *plugin.QueryData, _ *
 	for pagesLeft {
 		response, err := session.AutoScalingClient.ListAutoScalingConfigurations(ctx, request)
 		if err != nil {
-			return nil, err
+			return nil, wrapServiceError(err, session, "ListAutoScalingConfigurations")
 		}
 
 		for _, configuration := range response.Items {
@@ -253,7 +218,7 @@ func getAutoScalingConfiguration(ctx context.Context, d *plugin.QueryData, h *pl
 
 	response, err := session.AutoScalingClient.GetAutoScalingConfiguration(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetAutoScalingConfiguration")
 	}
 
 	return response.AutoScalingConfiguration, nil
@@ -261,39 +226,6 @@ func getAutoScalingConfiguration(ctx context.Context, d *plugin.QueryData, h *pl
 
 //// TRANSFORM FUNCTION
 
-// Priority order for tags
-// 1. System Tags
-// 2. Defined Tags
-// 3. Free-form tags
-func autoScalingConfigurationTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
-	freeformTags := autoScalingConfigurationFreeformTags(d.HydrateItem)
-
-	var tags map[string]interface{}
-
-	if freeformTags != nil {
-		tags = map[string]interface{}{}
-		for k, v := range freeformTags {
-			tags[k] = v
-		}
-	}
-
-	definedTags := autoScalingConfigurationDefinedTags(d.HydrateItem)
-
-	if definedTags != nil {
-		if tags == nil {
-			tags = map[string]interface{}{}
-		}
-		for _, v := range definedTags {
-			for key, value := range v {
-				tags[key] = value
-			}
-
-		}
-	}
-
-	return tags, nil
-}
-
 func autoScalingConfigurationFreeformTags(item interface{}) map[string]string {
 	switch item := item.(type) {
 	case autoscaling.AutoScalingConfiguration:
@@ -26,6 +26,10 @@ func tableBudgetAlertRule(_ context.Context) *plugin.Table {
 			ParentHydrate: listBudgets,
 			Hydrate:       listBudgetAlertRules,
 			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "compartment_id",
+					Require: plugin.Optional,
+				},
 				{
 					Name:    "display_name",
 					Require: plugin.Optional,
@@ -37,7 +41,7 @@ func tableBudgetAlertRule(_ context.Context) *plugin.Table {
 			},
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
-		Columns: []*plugin.Column{
+		Columns: CommonColumns([]*plugin.Column{
 			{
 				Name:        "display_name",
 				Description: "The name of the alert rule.",
@@ -121,36 +125,7 @@ func tableBudgetAlertRule(_ context.Context) *plugin.Table {
 				Description: ColumnDescriptionFreefromTags,
 				Type:        proto.ColumnType_JSON,
 			},
-
-			// Standard Steampipe columns
-			{
-				Name:        "tags",
-				Description: ColumnDescriptionTags,
-				Type:        proto.ColumnType_JSON,
-				Transform:   transform.From(alertRuleTags),
-			},
-			{
-				Name:        "title",
-				Description: ColumnDescriptionTitle,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("DisplayName"),
-			},
-
-			// Standard OCI columns
-			{
-				Name:        "compartment_id",
-				Description: ColumnDescriptionCompartment,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("CompartmentId"),
-			},
-			{
-				Name:        "tenant_id",
-				Description: ColumnDescriptionTenant,
-				Type:        proto.ColumnType_STRING,
-				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
-				Transform:   transform.FromValue(),
-			},
-		},
+		}),
 	}
 }
 
@@ -258,7 +233,7 @@ func listBudgetAlertRules(ctx context.Context, d *plugin.QueryData, h *plugin.Hy
 	for pagesLeft {
 		response, err := session.BudgetClient.ListAlertRules(ctx, request)
 		if err != nil {
-			return nil, err
+			return nil, wrapServiceError(err, session, "ListAlertRules")
 		}
 		for _, rule := range response.Items {
 			d.StreamListItem(ctx, AlertRuleInfo{rule.Id, rule.BudgetId, rule.DisplayName, rule.Type, rule.Threshold, rule.ThresholdType, rule.LifecycleState, rule.Recipients, rule.TimeCreated, rule.TimeUpdated, rule.Message, rule.Description, rule.Version, rule.FreeformTags, rule.DefinedTags, compartment})
@@ -315,41 +290,10 @@ func getBudgetAlertRule(ctx context.Context, d *plugin.QueryData, h *plugin.Hydr
 
 	response, err := session.BudgetClient.GetAlertRule(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetAlertRule")
 	}
 
 	rule := response.AlertRule
 
 	return AlertRuleInfo{rule.Id, rule.BudgetId, rule.DisplayName, rule.Type, rule.Threshold, rule.ThresholdType, rule.LifecycleState, rule.Recipients, rule.TimeCreated, rule.TimeUpdated, rule.Message, rule.Description, rule.Version, rule.FreeformTags, rule.DefinedTags, compartment}, nil
 }
-
-//// TRANSFORM FUNCTION
-
-func alertRuleTags(ctx context.Context, d *transform.TransformData) (interface{}, error) {
-	freeformTags := d.HydrateItem.(AlertRuleInfo).FreeformTags
-
-	var tags map[string]interface{}
-
-	if freeformTags != nil {
-		tags = map[string]interface{}{}
-		for k, v := range freeformTags {
-			tags[k] = v
-		}
-	}
-
-	definedTags := d.HydrateItem.(AlertRuleInfo).DefinedTags
-
-	if definedTags != nil {
-		if tags == nil {
-			tags = map[string]interface{}{}
-		}
-		for _, v := range definedTags {
-			for key, value := range v {
-				tags[key] = value
-			}
-
-		}
-	}
-
-	return tags, nil
-}
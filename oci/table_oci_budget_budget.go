@@ -40,7 +40,7 @@ func tableBudget(_ context.Context) *plugin.Table {
 			},
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
-		Columns: []*plugin.Column{
+		Columns: CommonColumns([]*plugin.Column{
 			{
 				Name:        "display_name",
 				Description: "The display name of the budget.",
@@ -147,36 +147,7 @@ func tableBudget(_ context.Context) *plugin.Table {
 				Description: ColumnDescriptionFreefromTags,
 				Type:        proto.ColumnType_JSON,
 			},
-
-			// Standard Steampipe columns
-			{
-				Name:        "tags",
-				Description: ColumnDescriptionTags,
-				Type:        proto.ColumnType_JSON,
-				Transform:   transform.From(budgetTags),
-			},
-			{
-				Name:        "title",
-				Description: ColumnDescriptionTitle,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("DisplayName"),
-			},
-
-			// Standard OCI columns
-			{
-				Name:        "compartment_id",
-				Description: ColumnDescriptionCompartment,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("CompartmentId"),
-			},
-			{
-				Name:        "tenant_id",
-				Description: ColumnDescriptionTenant,
-				Type:        proto.ColumnType_STRING,
-				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
-				Transform:   transform.FromValue(),
-			},
-		},
+		}),
 	}
 }
 
@@ -232,7 +203,7 @@ func listBudgets(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData
 	for pagesLeft {
 		response, err := session.BudgetClient.ListBudgets(ctx, request)
 		if err != nil {
-			return nil, err
+			return nil, wrapServiceError(err, session, "ListBudgets")
 		}
 		for _, budget := range response.Items {
 			d.StreamListItem(ctx, budget)
@@ -287,49 +258,8 @@ func getBudget(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData)
 
 	response, err := session.BudgetClient.GetBudget(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetBudget")
 	}
 
 	return response.Budget, nil
 }
-
-//// TRANSFORM FUNCTION
-
-func budgetTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
-	var freeformTags map[string]string
-	var definedTags map[string]map[string]interface{}
-
-	switch d.HydrateItem.(type) {
-	case budget.BudgetSummary:
-		budget := d.HydrateItem.(budget.BudgetSummary)
-		freeformTags = budget.FreeformTags
-		definedTags = budget.DefinedTags
-	case budget.Budget:
-		budget := d.HydrateItem.(budget.Budget)
-		freeformTags = budget.FreeformTags
-		definedTags = budget.DefinedTags
-	}
-
-	var tags map[string]interface{}
-
-	if freeformTags != nil {
-		tags = map[string]interface{}{}
-		for k, v := range freeformTags {
-			tags[k] = v
-		}
-	}
-
-	if definedTags != nil {
-		if tags == nil {
-			tags = map[string]interface{}{}
-		}
-		for _, v := range definedTags {
-			for key, value := range v {
-				tags[key] = value
-			}
-
-		}
-	}
-
-	return tags, nil
-}
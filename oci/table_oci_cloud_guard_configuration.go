@@ -6,7 +6,6 @@ import (
 	"github.com/oracle/oci-go-sdk/v44/cloudguard"
 	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
-	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
 )
 
 //// TABLE DEFINITION
@@ -18,7 +17,8 @@ func tableCloudGuardConfiguration(_ context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: listCloudGuardConfigurations,
 		},
-		Columns: []*plugin.Column{
+		GetMatrixItemFunc: BuildTenancyList,
+		Columns: TenancyColumns([]*plugin.Column{
 			{
 				Name:        "reporting_region",
 				Description: "The reporting region value.",
@@ -34,23 +34,14 @@ func tableCloudGuardConfiguration(_ context.Context) *plugin.Table {
 				Description: "Identifies if Oracle managed resources were created by customers.",
 				Type:        proto.ColumnType_BOOL,
 			},
-
-			// Standard OCI columns
-			{
-				Name:        "tenant_id",
-				Description: ColumnDescriptionTenant,
-				Type:        proto.ColumnType_STRING,
-				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
-				Transform:   transform.FromValue(),
-			},
-		},
+		}),
 	}
 }
 
 //// LIST FUNCTION
 
 func listCloudGuardConfigurations(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
-	getCloudGuardConfigurationCached := plugin.HydrateFunc(getCloudGuardConfiguration).WithCache()
+	getCloudGuardConfigurationCached := plugin.HydrateFunc(getCloudGuardConfiguration).WithCache(tenancyCacheKey("getCloudGuardConfiguration"))
 	configuration, err := getCloudGuardConfigurationCached(ctx, d, h)
 	if err != nil {
 		return nil, err
@@ -40,7 +40,7 @@ func tableCloudGuardDetectorRecipe(_ context.Context) *plugin.Table {
 			},
 		},
 		GetMatrixItemFunc: BuildCompartmentList,
-		Columns: []*plugin.Column{
+		Columns: CommonColumns([]*plugin.Column{
 			{
 				Name:        "name",
 				Description: "DisplayName of detector recipe.",
@@ -123,36 +123,7 @@ func tableCloudGuardDetectorRecipe(_ context.Context) *plugin.Table {
 				Description: ColumnDescriptionSystemTags,
 				Type:        proto.ColumnType_JSON,
 			},
-
-			// Standard Steampipe columns
-			{
-				Name:        "tags",
-				Description: ColumnDescriptionTags,
-				Type:        proto.ColumnType_JSON,
-				Transform:   transform.From(cloudGuardDetectorRecipeTags),
-			},
-			{
-				Name:        "title",
-				Description: ColumnDescriptionTitle,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("DisplayName"),
-			},
-
-			// Standard OCI columns
-			{
-				Name:        "compartment_id",
-				Description: ColumnDescriptionCompartment,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("CompartmentId"),
-			},
-			{
-				Name:        "tenant_id",
-				Description: ColumnDescriptionTenant,
-				Type:        proto.ColumnType_STRING,
-				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
-				Transform:   transform.FromValue(),
-			},
-		},
+		}),
 	}
 }
 
@@ -171,7 +142,7 @@ func listCloudGuardDetectorRecipes(ctx context.Context, d *plugin.QueryData, h *
 	}
 
 	// fetch reporting region from configuration
-	getCloudGuardConfigurationCached := plugin.HydrateFunc(getCloudGuardConfiguration).WithCache()
+	getCloudGuardConfigurationCached := plugin.HydrateFunc(getCloudGuardConfiguration).WithCache(tenancyCacheKey("getCloudGuardConfiguration"))
 	configuration, err := getCloudGuardConfigurationCached(ctx, d, h)
 	if err != nil {
 		return nil, err
@@ -215,7 +186,7 @@ func listCloudGuardDetectorRecipes(ctx context.Context, d *plugin.QueryData, h *
 	for pagesLeft {
 		response, err := session.CloudGuardClient.ListDetectorRecipes(ctx, request)
 		if err != nil {
-			return nil, err
+			return nil, wrapServiceError(err, session, "ListDetectorRecipes")
 		}
 		for _, detectorRecipe := range response.Items {
 			d.StreamListItem(ctx, detectorRecipe)
@@ -254,7 +225,7 @@ func getCloudGuardDetectorRecipe(ctx context.Context, d *plugin.QueryData, h *pl
 	}
 
 	// fetch reporting region from configuration
-	getCloudGuardConfigurationCached := plugin.HydrateFunc(getCloudGuardConfiguration).WithCache()
+	getCloudGuardConfigurationCached := plugin.HydrateFunc(getCloudGuardConfiguration).WithCache(tenancyCacheKey("getCloudGuardConfiguration"))
 	configuration, err := getCloudGuardConfigurationCached(ctx, d, h)
 	if err != nil {
 		return nil, err
@@ -277,69 +248,8 @@ func getCloudGuardDetectorRecipe(ctx context.Context, d *plugin.QueryData, h *pl
 
 	response, err := session.CloudGuardClient.GetDetectorRecipe(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetDetectorRecipe")
 	}
 
 	return response.DetectorRecipe, nil
 }
-
-//// TRANSFORM FUNCTION
-
-// Priority order for tags
-// 1. System Tags
-// 2. Defined Tags
-// 3. Free-form tags
-func cloudGuardDetectorRecipeTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
-
-	var freeformTags map[string]string
-	var definedTags map[string]map[string]interface{}
-	var systemTags map[string]map[string]interface{}
-
-	switch d.HydrateItem.(type) {
-	case cloudguard.DetectorRecipeSummary:
-		detectorRecipe := d.HydrateItem.(cloudguard.DetectorRecipeSummary)
-		freeformTags = detectorRecipe.FreeformTags
-		definedTags = detectorRecipe.DefinedTags
-		systemTags = detectorRecipe.SystemTags
-	case cloudguard.DetectorRecipe:
-		detectorRecipe := d.HydrateItem.(cloudguard.DetectorRecipe)
-		freeformTags = detectorRecipe.FreeformTags
-		definedTags = detectorRecipe.DefinedTags
-		systemTags = detectorRecipe.SystemTags
-	}
-
-	var tags map[string]interface{}
-
-	if freeformTags != nil {
-		tags = map[string]interface{}{}
-		for k, v := range freeformTags {
-			tags[k] = v
-		}
-	}
-
-	if definedTags != nil {
-		if tags == nil {
-			tags = map[string]interface{}{}
-		}
-		for _, v := range definedTags {
-			for key, value := range v {
-				tags[key] = value
-			}
-
-		}
-	}
-
-	if systemTags != nil {
-		if tags == nil {
-			tags = map[string]interface{}{}
-		}
-		for _, v := range systemTags {
-			for key, value := range v {
-				tags[key] = value
-			}
-
-		}
-	}
-
-	return tags, nil
-}
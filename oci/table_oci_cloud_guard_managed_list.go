@@ -44,7 +44,7 @@ func tableCloudGuardManagedList(_ context.Context) *plugin.Table {
 			},
 		},
 		GetMatrixItemFunc: BuildCompartmentList,
-		Columns: []*plugin.Column{
+		Columns: CommonColumns([]*plugin.Column{
 			{
 				Name:        "name",
 				Description: "ManagedList display name.",
@@ -131,36 +131,7 @@ func tableCloudGuardManagedList(_ context.Context) *plugin.Table {
 				Description: ColumnDescriptionSystemTags,
 				Type:        proto.ColumnType_JSON,
 			},
-
-			// Standard Steampipe columns
-			{
-				Name:        "tags",
-				Description: ColumnDescriptionTags,
-				Type:        proto.ColumnType_JSON,
-				Transform:   transform.From(cloudGuardManagedListTags),
-			},
-			{
-				Name:        "title",
-				Description: ColumnDescriptionTitle,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("DisplayName"),
-			},
-
-			// Standard OCI columns
-			{
-				Name:        "compartment_id",
-				Description: ColumnDescriptionCompartment,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("CompartmentId"),
-			},
-			{
-				Name:        "tenant_id",
-				Description: ColumnDescriptionTenant,
-				Type:        proto.ColumnType_STRING,
-				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
-				Transform:   transform.FromValue(),
-			},
-		},
+		}),
 	}
 }
 
@@ -179,7 +150,7 @@ func listCloudGuardManagedLists(ctx context.Context, d *plugin.QueryData, h *plu
 	}
 
 	// fetch reporting region from configuration
-	getCloudGuardConfigurationCached := plugin.HydrateFunc(getCloudGuardConfiguration).WithCache()
+	getCloudGuardConfigurationCached := plugin.HydrateFunc(getCloudGuardConfiguration).WithCache(tenancyCacheKey("getCloudGuardConfiguration"))
 	configuration, err := getCloudGuardConfigurationCached(ctx, d, h)
 	if err != nil {
 		return nil, err
@@ -212,7 +183,7 @@ func listCloudGuardManagedLists(ctx context.Context, d *plugin.QueryData, h *plu
 	for pagesLeft {
 		response, err := session.CloudGuardClient.ListManagedLists(ctx, request)
 		if err != nil {
-			return nil, err
+			return nil, wrapServiceError(err, session, "ListManagedLists")
 		}
 		for _, managedList := range response.Items {
 			d.StreamListItem(ctx, managedList)
@@ -252,7 +223,7 @@ func getCloudGuardManagedList(ctx context.Context, d *plugin.QueryData, h *plugi
 	}
 
 	// fetch reporting region from configuration
-	getCloudGuardConfigurationCached := plugin.HydrateFunc(getCloudGuardConfiguration).WithCache()
+	getCloudGuardConfigurationCached := plugin.HydrateFunc(getCloudGuardConfiguration).WithCache(tenancyCacheKey("getCloudGuardConfiguration"))
 	configuration, err := getCloudGuardConfigurationCached(ctx, d, h)
 	if err != nil {
 		return nil, err
@@ -275,7 +246,7 @@ func getCloudGuardManagedList(ctx context.Context, d *plugin.QueryData, h *plugi
 
 	response, err := session.CloudGuardClient.GetManagedList(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetManagedList")
 	}
 
 	return response.ManagedList, nil
@@ -283,65 +254,6 @@ func getCloudGuardManagedList(ctx context.Context, d *plugin.QueryData, h *plugi
 
 //// TRANSFORM FUNCTION
 
-// Priority order for tags
-// 1. System Tags
-// 2. Defined Tags
-// 3. Free-form tags
-func cloudGuardManagedListTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
-
-	var freeformTags map[string]string
-	var definedTags map[string]map[string]interface{}
-	var systemTags map[string]map[string]interface{}
-
-	switch d.HydrateItem.(type) {
-	case cloudguard.ManagedListSummary:
-		managedList := d.HydrateItem.(cloudguard.ManagedListSummary)
-		freeformTags = managedList.FreeformTags
-		definedTags = managedList.DefinedTags
-		systemTags = managedList.SystemTags
-	case cloudguard.ManagedList:
-		managedList := d.HydrateItem.(cloudguard.ManagedList)
-		freeformTags = managedList.FreeformTags
-		definedTags = managedList.DefinedTags
-		systemTags = managedList.SystemTags
-	}
-
-	var tags map[string]interface{}
-
-	if freeformTags != nil {
-		tags = map[string]interface{}{}
-		for k, v := range freeformTags {
-			tags[k] = v
-		}
-	}
-
-	if definedTags != nil {
-		if tags == nil {
-			tags = map[string]interface{}{}
-		}
-		for _, v := range definedTags {
-			for key, value := range v {
-				tags[key] = value
-			}
-
-		}
-	}
-
-	if systemTags != nil {
-		if tags == nil {
-			tags = map[string]interface{}{}
-		}
-		for _, v := range systemTags {
-			for key, value := range v {
-				tags[key] = value
-			}
-
-		}
-	}
-
-	return tags, nil
-}
-
 // Build additional filters
 func buildCloudGuardManagedListFilters(equalQuals plugin.KeyColumnEqualsQualMap) cloudguard.ListManagedListsRequest {
 	request := cloudguard.ListManagedListsRequest{}
@@ -40,7 +40,7 @@ func tableCloudGuardTarget(_ context.Context) *plugin.Table {
 			},
 		},
 		GetMatrixItemFunc: BuildCompartmentList,
-		Columns: []*plugin.Column{
+		Columns: CommonColumns([]*plugin.Column{
 			{
 				Name:        "name",
 				Description: "Target display name.",
@@ -136,36 +136,7 @@ func tableCloudGuardTarget(_ context.Context) *plugin.Table {
 				Description: ColumnDescriptionSystemTags,
 				Type:        proto.ColumnType_JSON,
 			},
-
-			// Standard Steampipe columns
-			{
-				Name:        "tags",
-				Description: ColumnDescriptionTags,
-				Type:        proto.ColumnType_JSON,
-				Transform:   transform.From(cloudGuardTargetTags),
-			},
-			{
-				Name:        "title",
-				Description: ColumnDescriptionTitle,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("DisplayName"),
-			},
-
-			// Standard OCI columns
-			{
-				Name:        "compartment_id",
-				Description: ColumnDescriptionCompartment,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("CompartmentId"),
-			},
-			{
-				Name:        "tenant_id",
-				Description: ColumnDescriptionTenant,
-				Type:        proto.ColumnType_STRING,
-				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
-				Transform:   transform.FromValue(),
-			},
-		},
+		}),
 	}
 }
 
@@ -184,7 +155,7 @@ func listCloudGuardTargets(ctx context.Context, d *plugin.QueryData, h *plugin.H
 	}
 
 	// fetch reporting region from configuration
-	getCloudGuardConfigurationCached := plugin.HydrateFunc(getCloudGuardConfiguration).WithCache()
+	getCloudGuardConfigurationCached := plugin.HydrateFunc(getCloudGuardConfiguration).WithCache(tenancyCacheKey("getCloudGuardConfiguration"))
 	configuration, err := getCloudGuardConfigurationCached(ctx, d, h)
 	if err != nil {
 		return nil, err
@@ -228,7 +199,7 @@ func listCloudGuardTargets(ctx context.Context, d *plugin.QueryData, h *plugin.H
 	for pagesLeft {
 		response, err := session.CloudGuardClient.ListTargets(ctx, request)
 		if err != nil {
-			return nil, err
+			return nil, wrapServiceError(err, session, "ListTargets")
 		}
 		for _, target := range response.Items {
 			d.StreamListItem(ctx, target)
@@ -272,7 +243,7 @@ func getCloudGuardTarget(ctx context.Context, d *plugin.QueryData, h *plugin.Hyd
 	}
 
 	// fetch reporting region from configuration
-	getCloudGuardConfigurationCached := plugin.HydrateFunc(getCloudGuardConfiguration).WithCache()
+	getCloudGuardConfigurationCached := plugin.HydrateFunc(getCloudGuardConfiguration).WithCache(tenancyCacheKey("getCloudGuardConfiguration"))
 	configuration, err := getCloudGuardConfigurationCached(ctx, d, h)
 	if err != nil {
 		return nil, err
@@ -295,69 +266,8 @@ func getCloudGuardTarget(ctx context.Context, d *plugin.QueryData, h *plugin.Hyd
 
 	response, err := session.CloudGuardClient.GetTarget(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetTarget")
 	}
 
 	return response.Target, nil
 }
-
-//// TRANSFORM FUNCTION
-
-// Priority order for tags
-// 1. System Tags
-// 2. Defined Tags
-// 3. Free-form tags
-func cloudGuardTargetTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
-
-	var freeformTags map[string]string
-	var definedTags map[string]map[string]interface{}
-	var systemTags map[string]map[string]interface{}
-
-	switch d.HydrateItem.(type) {
-	case cloudguard.TargetSummary:
-		target := d.HydrateItem.(cloudguard.TargetSummary)
-		freeformTags = target.FreeformTags
-		definedTags = target.DefinedTags
-		systemTags = target.SystemTags
-	case cloudguard.Target:
-		target := d.HydrateItem.(cloudguard.Target)
-		freeformTags = target.FreeformTags
-		definedTags = target.DefinedTags
-		systemTags = target.SystemTags
-	}
-
-	var tags map[string]interface{}
-
-	if freeformTags != nil {
-		tags = map[string]interface{}{}
-		for k, v := range freeformTags {
-			tags[k] = v
-		}
-	}
-
-	if definedTags != nil {
-		if tags == nil {
-			tags = map[string]interface{}{}
-		}
-		for _, v := range definedTags {
-			for key, value := range v {
-				tags[key] = value
-			}
-
-		}
-	}
-
-	if systemTags != nil {
-		if tags == nil {
-			tags = map[string]interface{}{}
-		}
-		for _, v := range systemTags {
-			for key, value := range v {
-				tags[key] = value
-			}
-
-		}
-	}
-
-	return tags, nil
-}
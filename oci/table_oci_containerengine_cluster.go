@@ -20,9 +20,9 @@ func tableOciContainerEngineCluster(_ context.Context) *plugin.Table {
 		Name:        "oci_containerengine_cluster",
 		Description: "OCI Container Engine Cluster",
 		Get: &plugin.GetConfig{
-			KeyColumns:        plugin.SingleColumn("id"),
-			ShouldIgnoreError: isNotFoundError([]string{"404"}),
-			Hydrate:           getContainerEngineCluster,
+			KeyColumns:   plugin.SingleColumn("id"),
+			IgnoreConfig: &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: isNotFoundError([]string{"404"})},
+			Hydrate:      getContainerEngineCluster,
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listContainerEngineClusters,
@@ -42,7 +42,7 @@ func tableOciContainerEngineCluster(_ context.Context) *plugin.Table {
 			},
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
-		Columns: []*plugin.Column{
+		Columns: CommonColumns([]*plugin.Column{
 			{
 				Name:        "name",
 				Description: "A user-friendly name. It does not have to be unique, and it is changeable.",
@@ -123,28 +123,7 @@ func tableOciContainerEngineCluster(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("Name"),
 			},
-
-			// Standard OCI columns
-			{
-				Name:        "region",
-				Description: ColumnDescriptionRegion,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("Id").Transform(ociRegionName),
-			},
-			{
-				Name:        "compartment_id",
-				Description: ColumnDescriptionCompartment,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("CompartmentId"),
-			},
-			{
-				Name:        "tenant_id",
-				Description: ColumnDescriptionTenant,
-				Type:        proto.ColumnType_STRING,
-				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
-				Transform:   transform.FromValue(),
-			},
-		},
+		}),
 	}
 }
 
@@ -191,7 +170,7 @@ func listContainerEngineClusters(ctx context.Context, d *plugin.QueryData, _ *pl
 	for pagesLeft {
 		response, err := session.ContainerEngineClient.ListClusters(ctx, request)
 		if err != nil {
-			return nil, err
+			return nil, wrapServiceError(err, session, "ListClusters")
 		}
 
 		for _, cluster := range response.Items {
@@ -251,7 +230,7 @@ func getContainerEngineCluster(ctx context.Context, d *plugin.QueryData, h *plug
 
 	response, err := session.ContainerEngineClient.GetCluster(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetCluster")
 	}
 	return response.Cluster, nil
 }
@@ -44,7 +44,7 @@ func tableCoreBlockVolumeReplica(_ context.Context) *plugin.Table {
 			},
 		},
 		GetMatrixItemFunc: BuildCompartementZonalList,
-		Columns: []*plugin.Column{
+		Columns: CommonColumns([]*plugin.Column{
 			{
 				Name:        "id",
 				Description: "The block volume replica's Oracle ID (OCID).",
@@ -110,42 +110,7 @@ func tableCoreBlockVolumeReplica(_ context.Context) *plugin.Table {
 				Description: ColumnDescriptionFreefromTags,
 				Type:        proto.ColumnType_JSON,
 			},
-
-			// Standard Steampipe columns
-			{
-				Name:        "tags",
-				Description: ColumnDescriptionTags,
-				Type:        proto.ColumnType_JSON,
-				Transform:   transform.From(volumeReplicaTags),
-			},
-			{
-				Name:        "title",
-				Description: ColumnDescriptionTitle,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("DisplayName"),
-			},
-
-			// Standard OCI columns
-			{
-				Name:        "region",
-				Description: ColumnDescriptionRegion,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("Id").Transform(ociRegionName),
-			},
-			{
-				Name:        "compartment_id",
-				Description: ColumnDescriptionCompartment,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("CompartmentId"),
-			},
-			{
-				Name:        "tenant_id",
-				Description: ColumnDescriptionTenant,
-				Type:        proto.ColumnType_STRING,
-				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
-				Transform:   transform.FromValue(),
-			},
-		},
+		}),
 	}
 }
 
@@ -207,7 +172,7 @@ func listCoreBlockVolumeReplicas(ctx context.Context, d *plugin.QueryData, h *pl
 	for pagesLeft {
 		response, err := session.BlockstorageClient.ListBlockVolumeReplicas(ctx, request)
 		if err != nil {
-			return nil, err
+			return nil, wrapServiceError(err, session, "ListBlockVolumeReplicas")
 		}
 
 		for _, volumeReplica := range response.Items {
@@ -264,37 +229,8 @@ func getCoreBlockVolumeReplica(ctx context.Context, d *plugin.QueryData, _ *plug
 
 	response, err := session.BlockstorageClient.GetBlockVolumeReplica(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetBlockVolumeReplica")
 	}
 
 	return response.BlockVolumeReplica, nil
 }
-
-//// TRANSFORM FUNCTION
-
-func volumeReplicaTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
-	volumeReplica := d.HydrateItem.(core.BlockVolumeReplica)
-
-	var tags map[string]interface{}
-
-	if volumeReplica.FreeformTags != nil {
-		tags = map[string]interface{}{}
-		for k, v := range volumeReplica.FreeformTags {
-			tags[k] = v
-		}
-	}
-
-	if volumeReplica.DefinedTags != nil {
-		if tags == nil {
-			tags = map[string]interface{}{}
-		}
-		for _, v := range volumeReplica.DefinedTags {
-			for key, value := range v {
-				tags[key] = value
-			}
-
-		}
-	}
-
-	return tags, nil
-}
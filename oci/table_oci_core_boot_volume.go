@@ -40,7 +40,7 @@ func tableCoreBootVolume(_ context.Context) *plugin.Table {
 			},
 		},
 		GetMatrixItemFunc: BuildCompartementZonalList,
-		Columns: []*plugin.Column{
+		Columns: CommonColumns([]*plugin.Column{
 			{
 				Name:        "id",
 				Description: "The OCID of the boot volume.",
@@ -154,42 +154,7 @@ func tableCoreBootVolume(_ context.Context) *plugin.Table {
 				Description: ColumnDescriptionSystemTags,
 				Type:        proto.ColumnType_JSON,
 			},
-
-			// Standard Steampipe columns
-			{
-				Name:        "tags",
-				Description: ColumnDescriptionTags,
-				Type:        proto.ColumnType_JSON,
-				Transform:   transform.From(bootVolumeTags),
-			},
-			{
-				Name:        "title",
-				Description: ColumnDescriptionTitle,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("DisplayName"),
-			},
-
-			// Standard OCI columns
-			{
-				Name:        "region",
-				Description: ColumnDescriptionRegion,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("Id").Transform(ociRegionName),
-			},
-			{
-				Name:        "compartment_id",
-				Description: ColumnDescriptionCompartment,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("CompartmentId"),
-			},
-			{
-				Name:        "tenant_id",
-				Description: ColumnDescriptionTenant,
-				Type:        proto.ColumnType_STRING,
-				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
-				Transform:   transform.FromValue(),
-			},
-		},
+		}),
 	}
 }
 
@@ -245,7 +210,7 @@ func listBootVolumes(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrate
 	for pagesLeft {
 		response, err := session.BlockstorageClient.ListBootVolumes(ctx, request)
 		if err != nil {
-			return nil, err
+			return nil, wrapServiceError(err, session, "ListBootVolumes")
 		}
 
 		for _, volume := range response.Items {
@@ -303,7 +268,7 @@ func getBootVolume(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateDa
 
 	response, err := session.BlockstorageClient.GetBootVolume(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetBootVolume")
 	}
 
 	return response.BootVolume, nil
@@ -331,7 +296,7 @@ func getBootVolumeBackupPolicyAssignment(ctx context.Context, d *plugin.QueryDat
 	response, err := session.BlockstorageClient.GetVolumeBackupPolicyAssetAssignment(ctx, request)
 	if err != nil {
 		plugin.Logger(ctx).Error("getBootVolumeBackupPolicyAssignment", "err", err)
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetVolumeBackupPolicyAssetAssignment")
 	}
 
 	if len(response.Items) > 0 {
@@ -340,48 +305,3 @@ func getBootVolumeBackupPolicyAssignment(ctx context.Context, d *plugin.QueryDat
 
 	return nil, nil
 }
-
-//// TRANSFORM FUNCTION
-
-// Priority order for tags
-// 1. System Tags
-// 2. Defined Tags
-// 3. Free-form tags
-func bootVolumeTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
-	bootVolume := d.HydrateItem.(core.BootVolume)
-
-	var tags map[string]interface{}
-
-	if bootVolume.FreeformTags != nil {
-		tags = map[string]interface{}{}
-		for k, v := range bootVolume.FreeformTags {
-			tags[k] = v
-		}
-	}
-
-	if bootVolume.DefinedTags != nil {
-		if tags == nil {
-			tags = map[string]interface{}{}
-		}
-		for _, v := range bootVolume.DefinedTags {
-			for key, value := range v {
-				tags[key] = value
-			}
-
-		}
-	}
-
-	if bootVolume.SystemTags != nil {
-		if tags == nil {
-			tags = map[string]interface{}{}
-		}
-		for _, v := range bootVolume.SystemTags {
-			for key, value := range v {
-				tags[key] = value
-			}
-
-		}
-	}
-
-	return tags, nil
-}
@@ -44,7 +44,7 @@ func tableCoreBootVolumeAttachment(_ context.Context) *plugin.Table {
 			},
 		},
 		GetMatrixItemFunc: BuildCompartementZonalList,
-		Columns: []*plugin.Column{
+		Columns: CommonColumns([]*plugin.Column{
 			{
 				Name:        "id",
 				Description: "The OCID of the boot volume attachment.",
@@ -96,36 +96,7 @@ func tableCoreBootVolumeAttachment(_ context.Context) *plugin.Table {
 				Description: "Whether in-transit encryption for the boot volume's paravirtualized attachment is enabled or not.",
 				Type:        proto.ColumnType_BOOL,
 			},
-
-			// Standard Steampipe columns
-			{
-				Name:        "title",
-				Description: ColumnDescriptionTitle,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("DisplayName"),
-			},
-
-			// Standard OCI columns
-			{
-				Name:        "region",
-				Description: ColumnDescriptionRegion,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("Id").Transform(ociRegionName),
-			},
-			{
-				Name:        "compartment_id",
-				Description: ColumnDescriptionCompartment,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("CompartmentId"),
-			},
-			{
-				Name:        "tenant_id",
-				Description: ColumnDescriptionTenant,
-				Type:        proto.ColumnType_STRING,
-				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
-				Transform:   transform.FromValue(),
-			},
-		},
+		}),
 	}
 }
 
@@ -187,7 +158,7 @@ func listCoreBootVolumeAttachments(ctx context.Context, d *plugin.QueryData, _ *
 	for pagesLeft {
 		response, err := session.ComputeClient.ListBootVolumeAttachments(ctx, request)
 		if err != nil {
-			return nil, err
+			return nil, wrapServiceError(err, session, "ListBootVolumeAttachments")
 		}
 
 		for _, volumeAttachment := range response.Items {
@@ -245,7 +216,7 @@ func getCoreBootVolumeAttachment(ctx context.Context, d *plugin.QueryData, _ *pl
 
 	response, err := session.ComputeClient.GetBootVolumeAttachment(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetBootVolumeAttachment")
 	}
 
 	return response.BootVolumeAttachment, nil
@@ -48,7 +48,7 @@ func tableCoreBootVolumeBackup(_ context.Context) *plugin.Table {
 			},
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
-		Columns: []*plugin.Column{
+		Columns: CommonColumns([]*plugin.Column{
 			{
 				Name:        "id",
 				Description: "The OCID of the boot volume backup.",
@@ -146,42 +146,7 @@ func tableCoreBootVolumeBackup(_ context.Context) *plugin.Table {
 				Description: "System tags for this resource.",
 				Type:        proto.ColumnType_JSON,
 			},
-
-			// Standard Steampipe columns
-			{
-				Name:        "tags",
-				Description: ColumnDescriptionTags,
-				Type:        proto.ColumnType_JSON,
-				Transform:   transform.From(bootVolumeBackupTags),
-			},
-			{
-				Name:        "title",
-				Description: ColumnDescriptionTitle,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("DisplayName"),
-			},
-
-			// Standard OCI columns
-			{
-				Name:        "region",
-				Description: ColumnDescriptionRegion,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("Id").Transform(ociRegionName),
-			},
-			{
-				Name:        "compartment_id",
-				Description: ColumnDescriptionCompartment,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("CompartmentId"),
-			},
-			{
-				Name:        "tenant_id",
-				Description: ColumnDescriptionTenant,
-				Type:        proto.ColumnType_STRING,
-				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
-				Transform:   transform.FromValue(),
-			},
-		},
+		}),
 	}
 }
 
@@ -225,7 +190,7 @@ func listBootVolumeBackups(ctx context.Context, d *plugin.QueryData, _ *plugin.H
 	for pagesLeft {
 		response, err := session.BlockstorageClient.ListBootVolumeBackups(ctx, request)
 		if err != nil {
-			return nil, err
+			return nil, wrapServiceError(err, session, "ListBootVolumeBackups")
 		}
 
 		for _, backup := range response.Items {
@@ -282,7 +247,7 @@ func getBootVolumeBackup(ctx context.Context, d *plugin.QueryData, _ *plugin.Hyd
 
 	response, err := session.BlockstorageClient.GetBootVolumeBackup(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetBootVolumeBackup")
 	}
 
 	return response.BootVolumeBackup, nil
@@ -290,49 +255,6 @@ func getBootVolumeBackup(ctx context.Context, d *plugin.QueryData, _ *plugin.Hyd
 
 //// TRANSFORM FUNCTION
 
-// Priority order for tags
-// 1. System Tags
-// 2. Defined Tags
-// 3. Free-form tags
-func bootVolumeBackupTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
-	bootVolumeBackup := d.HydrateItem.(core.BootVolumeBackup)
-
-	var tags map[string]interface{}
-
-	if bootVolumeBackup.FreeformTags != nil {
-		tags = map[string]interface{}{}
-		for k, v := range bootVolumeBackup.FreeformTags {
-			tags[k] = v
-		}
-	}
-
-	if bootVolumeBackup.DefinedTags != nil {
-		if tags == nil {
-			tags = map[string]interface{}{}
-		}
-		for _, v := range bootVolumeBackup.DefinedTags {
-			for key, value := range v {
-				tags[key] = value
-			}
-
-		}
-	}
-
-	if bootVolumeBackup.SystemTags != nil {
-		if tags == nil {
-			tags = map[string]interface{}{}
-		}
-		for _, v := range bootVolumeBackup.SystemTags {
-			for key, value := range v {
-				tags[key] = value
-			}
-
-		}
-	}
-
-	return tags, nil
-}
-
 // Build additional filters
 func buildBootVolumeBackupFilters(equalQuals plugin.KeyColumnEqualsQualMap) core.ListBootVolumeBackupsRequest {
 	request := core.ListBootVolumeBackupsRequest{}
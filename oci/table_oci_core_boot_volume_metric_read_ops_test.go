@@ -0,0 +1,162 @@
+package oci
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+)
+
+func TestCoreBootVolumeMetricReadOpsList(t *testing.T) {
+	rows, server, err := queryTable(t, "testdata/core_boot_volume_metric_read_ops", "oci_core_boot_volume_metric_read_ops", []string{"id", "timestamp", "average", "region"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// two data points of each of the 55 boot volumes, the block volumes of the namespace are skipped
+	if len(rows) != 110 {
+		t.Fatalf("returned %d rows, want 110", len(rows))
+	}
+	// rows are streamed concurrently, so they are compared by id and timestamp
+	averages := map[string]float64{}
+	for _, row := range rows {
+		if region := row["region"].GetStringValue(); region != "us-ashburn-1" {
+			t.Errorf("row %v region = %s, want us-ashburn-1", row, region)
+		}
+		averages[row["id"].GetStringValue()+" "+row["timestamp"].GetTimestampValue().AsTime().Format(time.RFC3339)] = row["average"].GetDoubleValue()
+	}
+	for volume := 0; volume < 55; volume++ {
+		for i, timestamp := range []string{"2022-06-01T10:00:00Z", "2022-06-01T10:05:00Z"} {
+			key := fmt.Sprintf("ocid1.bootvolume.oc1.iad.aaaaaaaa%02d %s", volume, timestamp)
+			if average, ok := averages[key]; !ok || average != float64(volume)+float64(i)/2 {
+				t.Errorf("average of %s = %f, want %f", key, average, float64(volume)+float64(i)/2)
+			}
+		}
+	}
+
+	listMetrics, queries := 0, []string{}
+	for _, request := range server.Requests() {
+		if request.Service != "monitoring" {
+			continue
+		}
+		var details map[string]interface{}
+		if err := json.Unmarshal([]byte(request.Body), &details); err != nil {
+			t.Fatal(err)
+		}
+		switch request.Path {
+		case "/20180401/metrics/actions/listMetrics":
+			if details["namespace"] != "oci_blockstore" || details["name"] != "VolumeReadOps" {
+				t.Errorf("ListMetrics details %v, want the VolumeReadOps metric of oci_blockstore", details)
+			}
+			// the first page is requested without a page token
+			want := ""
+			if listMetrics > 0 {
+				want = fmt.Sprint(listMetrics)
+			}
+			if page := request.Query.Get("page"); page != want {
+				t.Errorf("ListMetrics page %q, want %q", page, want)
+			}
+			listMetrics++
+		case "/20180401/metrics/actions/summarizeMetricsData":
+			queries = append(queries, details["query"].(string))
+		}
+	}
+	if listMetrics != 2 {
+		t.Errorf("sent %d ListMetrics requests, want one per page", listMetrics)
+	}
+
+	// the boot volumes are queried in batches of 50, once in the region
+	batches := [][]string{{}, {}}
+	for i := 0; i < 55; i++ {
+		batches[i/50] = append(batches[i/50], fmt.Sprintf("ocid1.bootvolume.oc1.iad.aaaaaaaa%02d", i))
+	}
+	if len(queries) != len(batches) {
+		t.Fatalf("sent queries %v, want %d", queries, len(batches))
+	}
+	for i, batch := range batches {
+		want := fmt.Sprintf("VolumeReadOps[5m]{resourceId =~ \"%s\"}.groupBy(resourceId).mean()", strings.Join(batch, "|"))
+		if queries[i] != want {
+			t.Errorf("query %d = %s, want %s", i, queries[i], want)
+		}
+	}
+}
+
+func TestCoreBootVolumeMetricReadOpsStatistics(t *testing.T) {
+	tests := []struct {
+		name      string
+		columns   []string
+		functions []string
+	}{
+		{"no statistics", []string{"id", "timestamp"}, []string{"mean()"}},
+		{"percentile", []string{"id", "timestamp", "p95"}, []string{"percentile(0.95)"}},
+		{"rate", []string{"id", "timestamp", "average", "rate"}, []string{"mean()", "rate()"}},
+		{"all", []string{"id", "timestamp", "average", "maximum", "p95", "rate"}, []string{"mean()", "max()", "percentile(0.95)", "rate()"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, server, err := queryTable(t, "testdata/core_boot_volume_metric_read_ops_statistics", "oci_core_boot_volume_metric_read_ops", test.columns, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			functions := []string{}
+			for _, request := range server.Requests() {
+				if request.Path != "/20180401/metrics/actions/summarizeMetricsData" {
+					continue
+				}
+				var details map[string]interface{}
+				if err := json.Unmarshal([]byte(request.Body), &details); err != nil {
+					t.Fatal(err)
+				}
+				query := details["query"].(string)
+				functions = append(functions, strings.TrimPrefix(query[strings.Index(query, ".groupBy("):], ".groupBy(resourceId)."))
+			}
+			if strings.Join(functions, " ") != strings.Join(test.functions, " ") {
+				t.Errorf("queried %v, want %v", functions, test.functions)
+			}
+		})
+	}
+}
+
+func TestCoreBootVolumeMetricReadOpsMerge(t *testing.T) {
+	rows, _, err := queryTable(t, "testdata/core_boot_volume_metric_read_ops_statistics", "oci_core_boot_volume_metric_read_ops", []string{"id", "timestamp", "average", "maximum", "p95", "rate"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the series of the statistics have gaps, which are null columns of the row of the timestamp
+	null := math.NaN()
+	want := map[string][]float64{
+		"ocid1.bootvolume.oc1.iad.aaaaaaaaa 10:00": {1, 10, null, 0.1},
+		"ocid1.bootvolume.oc1.iad.aaaaaaaaa 10:05": {2, null, 95, null},
+		"ocid1.bootvolume.oc1.iad.aaaaaaaaa 10:10": {3, 30, null, null},
+		"ocid1.bootvolume.oc1.iad.aaaaaaaab 10:05": {null, 50, null, null},
+	}
+	if len(rows) != len(want) {
+		t.Errorf("returned %d rows, want %d", len(rows), len(want))
+	}
+	for _, row := range rows {
+		key := row["id"].GetStringValue() + " " + row["timestamp"].GetTimestampValue().AsTime().Format("15:04")
+		values, ok := want[key]
+		if !ok {
+			t.Errorf("unexpected row %s", key)
+			continue
+		}
+		for i, column := range []string{"average", "maximum", "p95", "rate"} {
+			if math.IsNaN(values[i]) {
+				if value := row[column].GetValue(); value != nil {
+					if _, isNull := value.(*proto.Column_NullValue); !isNull {
+						t.Errorf("%s of %s = %v, want null", column, key, row[column])
+					}
+				}
+			} else if row[column].GetDoubleValue() != values[i] {
+				t.Errorf("%s of %s = %v, want %f", column, key, row[column], values[i])
+			}
+		}
+	}
+}
@@ -2,9 +2,7 @@ package oci
 
 import (
 	"context"
-	"fmt"
 
-	"github.com/oracle/oci-go-sdk/v44/core"
 	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
@@ -16,10 +14,10 @@ func tableOciCoreBootVolumeMetricWriteOps(_ context.Context) *plugin.Table {
 		Name:        "oci_core_boot_volume_metric_write_ops",
 		Description: "OCI Core Boot Volume Monitoring Metrics - Write Ops",
 		List: &plugin.ListConfig{
-			ParentHydrate: listBootVolumes,
-			Hydrate:       listCoreBootVolumeMetricWriteOps,
+			Hydrate:    listCoreBootVolumeMetricWriteOps,
+			KeyColumns: MonitoringMetricKeyColumns(),
 		},
-		GetMatrixItemFunc: BuildCompartementZonalList,
+		GetMatrixItemFunc: BuildCompartementRegionList,
 		Columns: MonitoringMetricColumns(
 			[]*plugin.Column{
 				{
@@ -32,9 +30,7 @@ func tableOciCoreBootVolumeMetricWriteOps(_ context.Context) *plugin.Table {
 	}
 }
 
-func listCoreBootVolumeMetricWriteOps(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
-	// We can get the metric details of volume, only which are attach with the instance or boot volume
-	volume := h.Item.(core.BootVolume)
-	region := fmt.Sprintf("%v", ociRegionNameFromId(*volume.Id))
-	return listMonitoringMetricStatistics(ctx, d, "5_MIN", "oci_blockstore", "VolumeWriteOps", "resourceId", *volume.Id, *volume.CompartmentId, region)
+func listCoreBootVolumeMetricWriteOps(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// boot volumes share the metrics of the oci_blockstore namespace with block volumes
+	return listMonitoringMetricStatistics(ctx, d, "5_MIN", "oci_blockstore", "VolumeWriteOps", "resourceId", "ocid1.bootvolume.")
 }
@@ -44,7 +44,7 @@ func tableCoreBootVolumeReplica(_ context.Context) *plugin.Table {
 			},
 		},
 		GetMatrixItemFunc: BuildCompartementZonalList,
-		Columns: []*plugin.Column{
+		Columns: CommonColumns([]*plugin.Column{
 			{
 				Name:        "id",
 				Description: "The boot volume replica's Oracle ID (OCID).",
@@ -116,42 +116,7 @@ func tableCoreBootVolumeReplica(_ context.Context) *plugin.Table {
 				Description: ColumnDescriptionFreefromTags,
 				Type:        proto.ColumnType_JSON,
 			},
-
-			// Standard Steampipe columns
-			{
-				Name:        "tags",
-				Description: ColumnDescriptionTags,
-				Type:        proto.ColumnType_JSON,
-				Transform:   transform.From(bootVolumeReplicaTags),
-			},
-			{
-				Name:        "title",
-				Description: ColumnDescriptionTitle,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("DisplayName"),
-			},
-
-			// Standard OCI columns
-			{
-				Name:        "region",
-				Description: ColumnDescriptionRegion,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("Id").Transform(ociRegionName),
-			},
-			{
-				Name:        "compartment_id",
-				Description: ColumnDescriptionCompartment,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("CompartmentId"),
-			},
-			{
-				Name:        "tenant_id",
-				Description: ColumnDescriptionTenant,
-				Type:        proto.ColumnType_STRING,
-				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
-				Transform:   transform.FromValue(),
-			},
-		},
+		}),
 	}
 }
 
@@ -213,7 +178,7 @@ func listCoreBootVolumeReplicas(ctx context.Context, d *plugin.QueryData, h *plu
 	for pagesLeft {
 		response, err := session.BlockstorageClient.ListBootVolumeReplicas(ctx, request)
 		if err != nil {
-			return nil, err
+			return nil, wrapServiceError(err, session, "ListBootVolumeReplicas")
 		}
 
 		for _, bootVolumeReplica := range response.Items {
@@ -270,37 +235,8 @@ func getCoreBootVolumeReplica(ctx context.Context, d *plugin.QueryData, _ *plugi
 
 	response, err := session.BlockstorageClient.GetBootVolumeReplica(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetBootVolumeReplica")
 	}
 
 	return response.BootVolumeReplica, nil
 }
-
-//// TRANSFORM FUNCTION
-
-func bootVolumeReplicaTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
-	volumeReplica := d.HydrateItem.(core.BootVolumeReplica)
-
-	var tags map[string]interface{}
-
-	if volumeReplica.FreeformTags != nil {
-		tags = map[string]interface{}{}
-		for k, v := range volumeReplica.FreeformTags {
-			tags[k] = v
-		}
-	}
-
-	if volumeReplica.DefinedTags != nil {
-		if tags == nil {
-			tags = map[string]interface{}{}
-		}
-		for _, v := range volumeReplica.DefinedTags {
-			for key, value := range v {
-				tags[key] = value
-			}
-
-		}
-	}
-
-	return tags, nil
-}
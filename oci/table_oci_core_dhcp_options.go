@@ -44,7 +44,7 @@ func tableCoreDhcpOptions(_ context.Context) *plugin.Table {
 			},
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
-		Columns: []*plugin.Column{
+		Columns: CommonColumns([]*plugin.Column{
 			{
 				Name:        "id",
 				Description: "The OCID of the DHCP options.",
@@ -92,42 +92,7 @@ func tableCoreDhcpOptions(_ context.Context) *plugin.Table {
 				Description: ColumnDescriptionFreefromTags,
 				Type:        proto.ColumnType_JSON,
 			},
-
-			// Standard Steampipe columns
-			{
-				Name:        "tags",
-				Description: ColumnDescriptionTags,
-				Type:        proto.ColumnType_JSON,
-				Transform:   transform.From(dhcpOptionTags),
-			},
-			{
-				Name:        "title",
-				Description: ColumnDescriptionTitle,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("DisplayName"),
-			},
-
-			// Standard OCI columns
-			{
-				Name:        "region",
-				Description: ColumnDescriptionRegion,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("Id").Transform(ociRegionName),
-			},
-			{
-				Name:        "compartment_id",
-				Description: ColumnDescriptionCompartment,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("CompartmentId"),
-			},
-			{
-				Name:        "tenant_id",
-				Description: ColumnDescriptionTenant,
-				Type:        proto.ColumnType_STRING,
-				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
-				Transform:   transform.FromValue(),
-			},
-		},
+		}),
 	}
 }
 
@@ -171,7 +136,7 @@ func listCoreDhcpOptions(ctx context.Context, d *plugin.QueryData, _ *plugin.Hyd
 	for pagesLeft {
 		response, err := session.VirtualNetworkClient.ListDhcpOptions(ctx, request)
 		if err != nil {
-			return nil, err
+			return nil, wrapServiceError(err, session, "ListDhcpOptions")
 		}
 
 		for _, dhcpOption := range response.Items {
@@ -227,7 +192,7 @@ func getCoreDhcpOption(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydra
 
 	response, err := session.VirtualNetworkClient.GetDhcpOptions(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetDhcpOptions")
 	}
 
 	return response.DhcpOptions, nil
@@ -235,32 +200,6 @@ func getCoreDhcpOption(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydra
 
 //// TRANSFORM FUNCTION
 
-func dhcpOptionTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
-	dhcpOption := d.HydrateItem.(core.DhcpOptions)
-
-	var tags map[string]interface{}
-
-	if dhcpOption.FreeformTags != nil {
-		tags = map[string]interface{}{}
-		for k, v := range dhcpOption.FreeformTags {
-			tags[k] = v
-		}
-	}
-
-	if dhcpOption.DefinedTags != nil {
-		if tags == nil {
-			tags = map[string]interface{}{}
-		}
-		for _, v := range dhcpOption.DefinedTags {
-			for key, value := range v {
-				tags[key] = value
-			}
-		}
-	}
-
-	return tags, nil
-}
-
 // Build additional filters
 func buildCoreDhcpOptionFilters(equalQuals plugin.KeyColumnEqualsQualMap) core.ListDhcpOptionsRequest {
 	request := core.ListDhcpOptionsRequest{}
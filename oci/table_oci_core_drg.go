@@ -32,7 +32,7 @@ func tableCoreDrg(_ context.Context) *plugin.Table {
 			},
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
-		Columns: []*plugin.Column{
+		Columns: CommonColumns([]*plugin.Column{
 			{
 				Name:        "display_name",
 				Description: "A user-friendly name. Does not have to be unique, and it's changeable.",
@@ -67,42 +67,7 @@ func tableCoreDrg(_ context.Context) *plugin.Table {
 				Description: ColumnDescriptionFreefromTags,
 				Type:        proto.ColumnType_JSON,
 			},
-
-			// Standard Steampipe columns
-			{
-				Name:        "tags",
-				Description: ColumnDescriptionTags,
-				Type:        proto.ColumnType_JSON,
-				Transform:   transform.From(drgTags),
-			},
-			{
-				Name:        "title",
-				Description: ColumnDescriptionTitle,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("DisplayName"),
-			},
-
-			// Standard OCI columns
-			{
-				Name:        "region",
-				Description: ColumnDescriptionRegion,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("Id").Transform(ociRegionName),
-			},
-			{
-				Name:        "compartment_id",
-				Description: ColumnDescriptionCompartment,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("CompartmentId"),
-			},
-			{
-				Name:        "tenant_id",
-				Description: ColumnDescriptionTenant,
-				Type:        proto.ColumnType_STRING,
-				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
-				Transform:   transform.FromValue(),
-			},
-		},
+		}),
 	}
 }
 
@@ -146,7 +111,7 @@ func listCoreDrgs(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateDat
 	for pagesLeft {
 		response, err := session.VirtualNetworkClient.ListDrgs(ctx, request)
 		if err != nil {
-			return nil, err
+			return nil, wrapServiceError(err, session, "ListDrgs")
 		}
 
 		for _, drg := range response.Items {
@@ -203,37 +168,8 @@ func getCoreDrg(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData)
 
 	response, err := session.VirtualNetworkClient.GetDrg(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetDrg")
 	}
 
 	return response.Drg, nil
 }
-
-//// TRANSFORM FUNCTION
-
-func drgTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
-	drg := d.HydrateItem.(core.Drg)
-
-	var tags map[string]interface{}
-
-	if drg.FreeformTags != nil {
-		tags = map[string]interface{}{}
-		for k, v := range drg.FreeformTags {
-			tags[k] = v
-		}
-	}
-
-	if drg.DefinedTags != nil {
-		if tags == nil {
-			tags = map[string]interface{}{}
-		}
-		for _, v := range drg.DefinedTags {
-			for key, value := range v {
-				tags[key] = value
-			}
-
-		}
-	}
-
-	return tags, nil
-}
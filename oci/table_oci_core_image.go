@@ -19,9 +19,9 @@ func tableCoreImage(_ context.Context) *plugin.Table {
 		Name:        "oci_core_image",
 		Description: "OCI Core Image",
 		Get: &plugin.GetConfig{
-			KeyColumns:        plugin.SingleColumn("id"),
-			ShouldIgnoreError: isNotFoundError([]string{"404", "400"}),
-			Hydrate:           getCoreImage,
+			KeyColumns:   plugin.SingleColumn("id"),
+			IgnoreConfig: &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: isNotFoundError([]string{"404", "400"})},
+			Hydrate:      getCoreImage,
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listCoreImages,
@@ -49,7 +49,7 @@ func tableCoreImage(_ context.Context) *plugin.Table {
 			},
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
-		Columns: []*plugin.Column{
+		Columns: CommonColumns([]*plugin.Column{
 			{
 				Name:        "display_name",
 				Description: "A user-friendly name for the image. It does not have to be unique, and it's changeable.",
@@ -127,42 +127,15 @@ func tableCoreImage(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 			},
 
-			// Standard Steampipe columns
-			{
-				Name:        "tags",
-				Description: ColumnDescriptionTags,
-				Type:        proto.ColumnType_JSON,
-				Transform:   transform.From(imageTags),
-			},
-			{
-				Name:        "title",
-				Description: ColumnDescriptionTitle,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("DisplayName"),
-			},
-
 			// Standard OCI columns
-			{
-				Name:        "region",
-				Description: ColumnDescriptionRegion,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("Id").Transform(ociRegionName),
-			},
 			{
 				Name:        "compartment_id",
 				Description: ColumnDescriptionCompartment,
 				Type:        proto.ColumnType_STRING,
-				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
+				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(tenancyCacheKey("getTenantId")),
 				Transform:   transform.FromValue(),
 			},
-			{
-				Name:        "tenant_id",
-				Description: ColumnDescriptionTenant,
-				Type:        proto.ColumnType_STRING,
-				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
-				Transform:   transform.FromValue(),
-			},
-		},
+		}),
 	}
 }
 
@@ -211,7 +184,7 @@ func listCoreImages(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateD
 	for pagesLeft {
 		response, err := session.ComputeClient.ListImages(ctx, request)
 		if err != nil {
-			return nil, err
+			return nil, wrapServiceError(err, session, "ListImages")
 		}
 
 		for _, image := range response.Items {
@@ -269,7 +242,7 @@ func getCoreImage(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateDat
 
 	response, err := session.ComputeClient.GetImage(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetImage")
 	}
 
 	return response.Image, nil
@@ -277,33 +250,6 @@ func getCoreImage(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateDat
 
 //// TRANSFORM FUNCTION
 
-func imageTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
-	instance := d.HydrateItem.(core.Image)
-
-	var tags map[string]interface{}
-
-	if instance.FreeformTags != nil {
-		tags = map[string]interface{}{}
-		for k, v := range instance.FreeformTags {
-			tags[k] = v
-		}
-	}
-
-	if instance.DefinedTags != nil {
-		if tags == nil {
-			tags = map[string]interface{}{}
-		}
-		for _, v := range instance.DefinedTags {
-			for key, value := range v {
-				tags[key] = value
-			}
-
-		}
-	}
-
-	return tags, nil
-}
-
 func buildImageFilter(equalQuals plugin.KeyColumnEqualsQualMap) core.ListImagesRequest {
 	request := core.ListImagesRequest{}
 
@@ -2,9 +2,7 @@ package oci
 
 import (
 	"context"
-	"fmt"
 
-	"github.com/oracle/oci-go-sdk/v44/core"
 	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
@@ -16,8 +14,8 @@ func tableOciCoreInstanceMetricCpuUtilization(_ context.Context) *plugin.Table {
 		Name:        "oci_core_instance_metric_cpu_utilization",
 		Description: "OCI Core Instance Monitoring Metrics - CPU Utilization",
 		List: &plugin.ListConfig{
-			ParentHydrate: listCoreInstances,
-			Hydrate:       listCoreInstanceMetricCpuUtilization,
+			Hydrate:    listCoreInstanceMetricCpuUtilization,
+			KeyColumns: MonitoringMetricKeyColumns(),
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
 		Columns: MonitoringMetricColumns(
@@ -32,8 +30,6 @@ func tableOciCoreInstanceMetricCpuUtilization(_ context.Context) *plugin.Table {
 	}
 }
 
-func listCoreInstanceMetricCpuUtilization(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
-	instance := h.Item.(core.Instance)
-	region := fmt.Sprintf("%v", ociRegionNameFromId(*instance.Id))
-	return listMonitoringMetricStatistics(ctx, d, "5_MIN", "oci_computeagent", "CpuUtilization", "resourceId", *instance.Id, *instance.CompartmentId, region)
+func listCoreInstanceMetricCpuUtilization(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	return listMonitoringMetricStatistics(ctx, d, "5_MIN", "oci_computeagent", "CpuUtilization", "resourceId", "")
 }
@@ -2,9 +2,7 @@ package oci
 
 import (
 	"context"
-	"fmt"
 
-	"github.com/oracle/oci-go-sdk/v44/core"
 	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
@@ -16,8 +14,8 @@ func tableOciCoreInstanceMetricCpuUtilizationHourly(_ context.Context) *plugin.T
 		Name:        "oci_core_instance_metric_cpu_utilization_hourly",
 		Description: "OCI Core Instance Monitoring Metrics - CPU Utilization",
 		List: &plugin.ListConfig{
-			ParentHydrate: listCoreInstances,
-			Hydrate:       listCoreInstanceMetricCpuUtilizationHourly,
+			Hydrate:    listCoreInstanceMetricCpuUtilizationHourly,
+			KeyColumns: MonitoringMetricKeyColumns(),
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
 		Columns: MonitoringMetricColumns(
@@ -32,8 +30,6 @@ func tableOciCoreInstanceMetricCpuUtilizationHourly(_ context.Context) *plugin.T
 	}
 }
 
-func listCoreInstanceMetricCpuUtilizationHourly(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
-	instance := h.Item.(core.Instance)
-	region := fmt.Sprintf("%v", ociRegionNameFromId(*instance.Id))
-	return listMonitoringMetricStatistics(ctx, d, "HOURLY", "oci_computeagent", "CpuUtilization", "resourceId", *instance.Id, *instance.CompartmentId, region)
+func listCoreInstanceMetricCpuUtilizationHourly(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	return listMonitoringMetricStatistics(ctx, d, "HOURLY", "oci_computeagent", "CpuUtilization", "resourceId", "")
 }
@@ -44,7 +44,7 @@ func tableCoreInternetGateway(_ context.Context) *plugin.Table {
 			},
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
-		Columns: []*plugin.Column{
+		Columns: CommonColumns([]*plugin.Column{
 			{
 				Name:        "id",
 				Description: "The internet gateway's Oracle ID (OCID).",
@@ -92,40 +92,13 @@ func tableCoreInternetGateway(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 			},
 
-			// Standard Steampipe columns
-			{
-				Name:        "tags",
-				Description: ColumnDescriptionTags,
-				Type:        proto.ColumnType_JSON,
-				Transform:   transform.From(internetGatewayTags),
-			},
-			{
-				Name:        "title",
-				Description: ColumnDescriptionTitle,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("DisplayName"),
-			},
-
 			// Standard OCI columns
 			{
 				Name:        "region",
 				Description: ColumnDescriptionRegion,
 				Type:        proto.ColumnType_STRING,
 			},
-			{
-				Name:        "compartment_id",
-				Description: ColumnDescriptionCompartment,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("CompartmentId"),
-			},
-			{
-				Name:        "tenant_id",
-				Description: ColumnDescriptionTenant,
-				Type:        proto.ColumnType_STRING,
-				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
-				Transform:   transform.FromValue(),
-			},
-		},
+		}),
 	}
 }
 
@@ -174,7 +147,7 @@ func listCoreInternetGateways(ctx context.Context, d *plugin.QueryData, _ *plugi
 	for pagesLeft {
 		response, err := session.VirtualNetworkClient.ListInternetGateways(ctx, request)
 		if err != nil {
-			return nil, err
+			return nil, wrapServiceError(err, session, "ListInternetGateways")
 		}
 
 		for _, internetGateway := range response.Items {
@@ -231,7 +204,7 @@ func getCoreInternetGateway(ctx context.Context, d *plugin.QueryData, _ *plugin.
 
 	response, err := session.VirtualNetworkClient.GetInternetGateway(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetInternetGateway")
 	}
 
 	return internetGatewayInfo{response.InternetGateway, region}, nil
@@ -239,37 +212,6 @@ func getCoreInternetGateway(ctx context.Context, d *plugin.QueryData, _ *plugin.
 
 //// TRANSFORM FUNCTION
 
-// Priority order for tags
-// 1. System Tags
-// 2. Defined Tags
-// 3. Free-form tags
-func internetGatewayTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
-	internetGateway := d.HydrateItem.(internetGatewayInfo).InternetGateway
-
-	var tags map[string]interface{}
-
-	if internetGateway.FreeformTags != nil {
-		tags = map[string]interface{}{}
-		for k, v := range internetGateway.FreeformTags {
-			tags[k] = v
-		}
-	}
-
-	if internetGateway.DefinedTags != nil {
-		if tags == nil {
-			tags = map[string]interface{}{}
-		}
-		for _, v := range internetGateway.DefinedTags {
-			for key, value := range v {
-				tags[key] = value
-			}
-
-		}
-	}
-
-	return tags, nil
-}
-
 // Build additional filters
 func buildCoreInternetGatewayFilters(equalQuals plugin.KeyColumnEqualsQualMap) core.ListInternetGatewaysRequest {
 	request := core.ListInternetGatewaysRequest{}
@@ -40,7 +40,7 @@ func tableCoreLoadBalancer(_ context.Context) *plugin.Table {
 			},
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
-		Columns: []*plugin.Column{
+		Columns: CommonColumns([]*plugin.Column{
 			{
 				Name:        "id",
 				Description: "The OCID of the load balancer.",
@@ -153,42 +153,7 @@ func tableCoreLoadBalancer(_ context.Context) *plugin.Table {
 				Description: "System tags for this resource.",
 				Type:        proto.ColumnType_JSON,
 			},
-
-			// Standard Steampipe columns
-			{
-				Name:        "tags",
-				Description: ColumnDescriptionTags,
-				Type:        proto.ColumnType_JSON,
-				Transform:   transform.From(loadBalancerTags),
-			},
-			{
-				Name:        "title",
-				Description: ColumnDescriptionTitle,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("DisplayName"),
-			},
-
-			// Standard OCI columns
-			{
-				Name:        "region",
-				Description: ColumnDescriptionRegion,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("Id").Transform(ociRegionName),
-			},
-			{
-				Name:        "compartment_id",
-				Description: ColumnDescriptionCompartment,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("CompartmentId"),
-			},
-			{
-				Name:        "tenant_id",
-				Description: ColumnDescriptionTenant,
-				Type:        proto.ColumnType_STRING,
-				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
-				Transform:   transform.FromValue(),
-			},
-		},
+		}),
 	}
 }
 
@@ -243,7 +208,7 @@ func listCoreLoadBalancers(ctx context.Context, d *plugin.QueryData, _ *plugin.H
 	for pagesLeft {
 		response, err := session.LoadBalancerClient.ListLoadBalancers(ctx, request)
 		if err != nil {
-			return nil, err
+			return nil, wrapServiceError(err, session, "ListLoadBalancers")
 		}
 
 		for _, loadBalancer := range response.Items {
@@ -300,47 +265,8 @@ func getCoreLoadBalancer(ctx context.Context, d *plugin.QueryData, _ *plugin.Hyd
 
 	response, err := session.LoadBalancerClient.GetLoadBalancer(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetLoadBalancer")
 	}
 
 	return response.LoadBalancer, nil
 }
-
-//// TRANSFORM FUNCTION
-
-func loadBalancerTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
-	loadBalancer := d.HydrateItem.(loadbalancer.LoadBalancer)
-
-	var tags map[string]interface{}
-
-	if loadBalancer.FreeformTags != nil {
-		tags = map[string]interface{}{}
-		for k, v := range loadBalancer.FreeformTags {
-			tags[k] = v
-		}
-	}
-
-	if loadBalancer.DefinedTags != nil {
-		if tags == nil {
-			tags = map[string]interface{}{}
-		}
-		for _, v := range loadBalancer.DefinedTags {
-			for key, value := range v {
-				tags[key] = value
-			}
-		}
-	}
-
-	if loadBalancer.SystemTags != nil {
-		if tags == nil {
-			tags = map[string]interface{}{}
-		}
-		for _, v := range loadBalancer.SystemTags {
-			for key, value := range v {
-				tags[key] = value
-			}
-		}
-	}
-
-	return tags, nil
-}
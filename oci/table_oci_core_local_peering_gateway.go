@@ -36,7 +36,7 @@ func tableCoreLocalPeeringGateway(_ context.Context) *plugin.Table {
 			Hydrate:    getCoreLocalPeeringGateway,
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
-		Columns: []*plugin.Column{
+		Columns: CommonColumns([]*plugin.Column{
 			{
 				Name:        "name",
 				Description: "A user-friendly name. Does not have to be unique, and it's changeable.",
@@ -113,42 +113,7 @@ func tableCoreLocalPeeringGateway(_ context.Context) *plugin.Table {
 				Description: ColumnDescriptionFreefromTags,
 				Type:        proto.ColumnType_JSON,
 			},
-
-			// Standard Steampipe columns
-			{
-				Name:        "tags",
-				Description: ColumnDescriptionTags,
-				Type:        proto.ColumnType_JSON,
-				Transform:   transform.From(localPeeringGatewayTags),
-			},
-			{
-				Name:        "title",
-				Description: ColumnDescriptionTitle,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("DisplayName"),
-			},
-
-			// Standard OCI columns
-			{
-				Name:        "region",
-				Description: ColumnDescriptionRegion,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("Id").Transform(ociRegionName),
-			},
-			{
-				Name:        "compartment_id",
-				Description: ColumnDescriptionCompartment,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("CompartmentId"),
-			},
-			{
-				Name:        "tenant_id",
-				Description: ColumnDescriptionTenant,
-				Type:        proto.ColumnType_STRING,
-				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
-				Transform:   transform.FromValue(),
-			},
-		},
+		}),
 	}
 }
 
@@ -198,7 +163,7 @@ func listCoreLocalPeeringGateways(ctx context.Context, d *plugin.QueryData, _ *p
 	for pagesLeft {
 		gateways, err := session.VirtualNetworkClient.ListLocalPeeringGateways(ctx, request)
 		if err != nil {
-			return nil, err
+			return nil, wrapServiceError(err, session, "ListLocalPeeringGateways")
 		}
 
 		for _, gateway := range gateways.Items {
@@ -252,40 +217,8 @@ func getCoreLocalPeeringGateway(ctx context.Context, d *plugin.QueryData, _ *plu
 
 	response, err := session.VirtualNetworkClient.GetLocalPeeringGateway(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetLocalPeeringGateway")
 	}
 
 	return response.LocalPeeringGateway, nil
 }
-
-//// TRANSFORM FUNCTION
-
-// Priority order for tags
-// 2. Defined Tags
-// 3. Free-form tags
-func localPeeringGatewayTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
-	localPeeringGateway := d.HydrateItem.(core.LocalPeeringGateway)
-
-	var tags map[string]interface{}
-
-	if localPeeringGateway.FreeformTags != nil {
-		tags = map[string]interface{}{}
-		for k, v := range localPeeringGateway.FreeformTags {
-			tags[k] = v
-		}
-	}
-
-	if localPeeringGateway.DefinedTags != nil {
-		if tags == nil {
-			tags = map[string]interface{}{}
-		}
-		for _, v := range localPeeringGateway.DefinedTags {
-			for key, value := range v {
-				tags[key] = value
-			}
-
-		}
-	}
-
-	return tags, nil
-}
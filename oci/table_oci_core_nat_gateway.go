@@ -19,9 +19,9 @@ func tableCoreNatGateway(_ context.Context) *plugin.Table {
 		Name:        "oci_core_nat_gateway",
 		Description: "OCI Core Nat Gateway",
 		Get: &plugin.GetConfig{
-			KeyColumns:        plugin.SingleColumn("id"),
-			ShouldIgnoreError: isNotFoundError([]string{"400", "404"}),
-			Hydrate:           getCoreNatGateway,
+			KeyColumns:   plugin.SingleColumn("id"),
+			IgnoreConfig: &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: isNotFoundError([]string{"400", "404"})},
+			Hydrate:      getCoreNatGateway,
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listCoreNatGateways,
@@ -45,7 +45,7 @@ func tableCoreNatGateway(_ context.Context) *plugin.Table {
 			},
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
-		Columns: []*plugin.Column{
+		Columns: CommonColumns([]*plugin.Column{
 			{
 				Name:        "id",
 				Description: "The OCID of the NAT gateway.",
@@ -103,42 +103,7 @@ func tableCoreNatGateway(_ context.Context) *plugin.Table {
 				Description: ColumnDescriptionFreefromTags,
 				Type:        proto.ColumnType_JSON,
 			},
-
-			// Standard Steampipe columns
-			{
-				Name:        "tags",
-				Description: ColumnDescriptionTags,
-				Type:        proto.ColumnType_JSON,
-				Transform:   transform.From(natGatewayTags),
-			},
-			{
-				Name:        "title",
-				Description: ColumnDescriptionTitle,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("DisplayName"),
-			},
-
-			// Standard OCI columns
-			{
-				Name:        "region",
-				Description: ColumnDescriptionRegion,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("Id").Transform(ociRegionName),
-			},
-			{
-				Name:        "compartment_id",
-				Description: ColumnDescriptionCompartment,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("CompartmentId"),
-			},
-			{
-				Name:        "tenant_id",
-				Description: ColumnDescriptionTenant,
-				Type:        proto.ColumnType_STRING,
-				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
-				Transform:   transform.FromValue(),
-			},
-		},
+		}),
 	}
 }
 
@@ -182,7 +147,7 @@ func listCoreNatGateways(ctx context.Context, d *plugin.QueryData, _ *plugin.Hyd
 	for pagesLeft {
 		response, err := session.VirtualNetworkClient.ListNatGateways(ctx, request)
 		if err != nil {
-			return nil, err
+			return nil, wrapServiceError(err, session, "ListNatGateways")
 		}
 
 		for _, natGateway := range response.Items {
@@ -238,7 +203,7 @@ func getCoreNatGateway(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydra
 
 	response, err := session.VirtualNetworkClient.GetNatGateway(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetNatGateway")
 	}
 
 	return response.NatGateway, nil
@@ -246,32 +211,6 @@ func getCoreNatGateway(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydra
 
 //// TRANSFORM FUNCTION
 
-func natGatewayTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
-	natGateway := d.HydrateItem.(core.NatGateway)
-
-	var tags map[string]interface{}
-
-	if natGateway.FreeformTags != nil {
-		tags = map[string]interface{}{}
-		for k, v := range natGateway.FreeformTags {
-			tags[k] = v
-		}
-	}
-
-	if natGateway.DefinedTags != nil {
-		if tags == nil {
-			tags = map[string]interface{}{}
-		}
-		for _, v := range natGateway.DefinedTags {
-			for key, value := range v {
-				tags[key] = value
-			}
-		}
-	}
-
-	return tags, nil
-}
-
 // Build additional filters
 func buildCoreNatGatewayFilters(equalQuals plugin.KeyColumnEqualsQualMap) core.ListNatGatewaysRequest {
 	request := core.ListNatGatewaysRequest{}
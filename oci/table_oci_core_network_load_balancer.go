@@ -40,7 +40,7 @@ func tableCoreNetworkLoadBalancer(_ context.Context) *plugin.Table {
 			},
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
-		Columns: []*plugin.Column{
+		Columns: CommonColumns([]*plugin.Column{
 			{
 				Name:        "display_name",
 				Description: "A user-friendly name. Does not have to be unique.",
@@ -124,42 +124,7 @@ func tableCoreNetworkLoadBalancer(_ context.Context) *plugin.Table {
 				Description: ColumnDescriptionSystemTags,
 				Type:        proto.ColumnType_JSON,
 			},
-
-			// Steampipe standard columns
-			{
-				Name:        "tags",
-				Description: ColumnDescriptionTags,
-				Type:        proto.ColumnType_JSON,
-				Transform:   transform.From(networkLoadBalancerTags),
-			},
-			{
-				Name:        "title",
-				Description: ColumnDescriptionTitle,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("DisplayName"),
-			},
-
-			// OCI standard columns
-			{
-				Name:        "region",
-				Description: ColumnDescriptionRegion,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("Id").Transform(ociRegionName),
-			},
-			{
-				Name:        "compartment_id",
-				Description: ColumnDescriptionCompartment,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("CompartmentId"),
-			},
-			{
-				Name:        "tenant_id",
-				Description: ColumnDescriptionTenant,
-				Type:        proto.ColumnType_STRING,
-				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
-				Transform:   transform.FromValue(),
-			},
-		},
+		}),
 	}
 }
 
@@ -214,7 +179,7 @@ func listCoreNetworkLoadBalancers(ctx context.Context, d *plugin.QueryData, _ *p
 	for pagesLeft {
 		response, err := session.NetworkLoadBalancerClient.ListNetworkLoadBalancers(ctx, request)
 		if err != nil {
-			return nil, err
+			return nil, wrapServiceError(err, session, "ListNetworkLoadBalancers")
 		}
 
 		for _, networkLoadBalancer := range response.Items {
@@ -271,7 +236,7 @@ func getCoreNetworkLoadBalancer(ctx context.Context, d *plugin.QueryData, _ *plu
 
 	response, err := session.NetworkLoadBalancerClient.GetNetworkLoadBalancer(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetNetworkLoadBalancer")
 	}
 
 	return response.NetworkLoadBalancer, nil
@@ -307,69 +272,8 @@ func getCoreNetworkLoadBalancerHealth(ctx context.Context, d *plugin.QueryData,
 
 	response, err := session.NetworkLoadBalancerClient.GetNetworkLoadBalancerHealth(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetNetworkLoadBalancerHealth")
 	}
 
 	return response.NetworkLoadBalancerHealth, nil
 }
-
-//// TRANSFORM FUNCTION
-
-// Priority order for tags
-// 1. System Tags
-// 2. Defined Tags
-// 3. Free-form tags
-func networkLoadBalancerTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
-
-	var freeformTags map[string]string
-	var definedTags map[string]map[string]interface{}
-	var systemTags map[string]map[string]interface{}
-
-	switch d.HydrateItem.(type) {
-	case networkloadbalancer.NetworkLoadBalancerSummary:
-		networkLoadBalancer := d.HydrateItem.(networkloadbalancer.NetworkLoadBalancerSummary)
-		freeformTags = networkLoadBalancer.FreeformTags
-		definedTags = networkLoadBalancer.DefinedTags
-		systemTags = networkLoadBalancer.SystemTags
-	case networkloadbalancer.NetworkLoadBalancer:
-		networkLoadBalancer := d.HydrateItem.(networkloadbalancer.NetworkLoadBalancer)
-		freeformTags = networkLoadBalancer.FreeformTags
-		definedTags = networkLoadBalancer.DefinedTags
-		systemTags = networkLoadBalancer.SystemTags
-	}
-
-	var tags map[string]interface{}
-
-	if freeformTags != nil {
-		tags = map[string]interface{}{}
-		for k, v := range freeformTags {
-			tags[k] = v
-		}
-	}
-
-	if definedTags != nil {
-		if tags == nil {
-			tags = map[string]interface{}{}
-		}
-		for _, v := range definedTags {
-			for key, value := range v {
-				tags[key] = value
-			}
-
-		}
-	}
-
-	if systemTags != nil {
-		if tags == nil {
-			tags = map[string]interface{}{}
-		}
-		for _, v := range systemTags {
-			for key, value := range v {
-				tags[key] = value
-			}
-
-		}
-	}
-
-	return tags, nil
-}
@@ -44,7 +44,7 @@ func tableCoreNetworkSecurityGroup(_ context.Context) *plugin.Table {
 			},
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
-		Columns: []*plugin.Column{
+		Columns: CommonColumns([]*plugin.Column{
 			{
 				Name:        "display_name",
 				Description: "A user-friendly name. Does not have to be unique.",
@@ -92,42 +92,7 @@ func tableCoreNetworkSecurityGroup(_ context.Context) *plugin.Table {
 				Description: ColumnDescriptionFreefromTags,
 				Type:        proto.ColumnType_JSON,
 			},
-
-			// Standard Steampipe columns
-			{
-				Name:        "tags",
-				Description: ColumnDescriptionTags,
-				Type:        proto.ColumnType_JSON,
-				Transform:   transform.From(networkSecurityGroupTags),
-			},
-			{
-				Name:        "title",
-				Description: ColumnDescriptionTitle,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("DisplayName"),
-			},
-
-			// Standard OCI columns
-			{
-				Name:        "region",
-				Description: ColumnDescriptionRegion,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("Id").Transform(ociRegionName),
-			},
-			{
-				Name:        "compartment_id",
-				Description: ColumnDescriptionCompartment,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("CompartmentId"),
-			},
-			{
-				Name:        "tenant_id",
-				Description: ColumnDescriptionTenant,
-				Type:        proto.ColumnType_STRING,
-				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
-				Transform:   transform.FromValue(),
-			},
-		},
+		}),
 	}
 }
 
@@ -171,7 +136,7 @@ func listCoreNetworkSecurityGroups(ctx context.Context, d *plugin.QueryData, _ *
 	for pagesLeft {
 		response, err := session.VirtualNetworkClient.ListNetworkSecurityGroups(ctx, request)
 		if err != nil {
-			return nil, err
+			return nil, wrapServiceError(err, session, "ListNetworkSecurityGroups")
 		}
 
 		for _, networkSecurityGroup := range response.Items {
@@ -228,7 +193,7 @@ func getCoreNetworkSecurityGroup(ctx context.Context, d *plugin.QueryData, _ *pl
 
 	response, err := session.VirtualNetworkClient.GetNetworkSecurityGroup(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetNetworkSecurityGroup")
 	}
 
 	return response.NetworkSecurityGroup, nil
@@ -260,7 +225,7 @@ func listCoreNetworkSecurityGroupRules(ctx context.Context, d *plugin.QueryData,
 	for pagesLeft {
 		response, err := session.VirtualNetworkClient.ListNetworkSecurityGroupSecurityRules(ctx, request)
 		if err != nil {
-			return nil, err
+			return nil, wrapServiceError(err, session, "ListNetworkSecurityGroupSecurityRules")
 		}
 
 		items = append(items, response.Items...)
@@ -276,37 +241,6 @@ func listCoreNetworkSecurityGroupRules(ctx context.Context, d *plugin.QueryData,
 
 //// TRANSFORM FUNCTION
 
-// Priority order for tags
-// 1. System Tags
-// 2. Defined Tags
-// 3. Free-form tags
-func networkSecurityGroupTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
-	networkSecurityGroup := d.HydrateItem.(core.NetworkSecurityGroup)
-
-	var tags map[string]interface{}
-
-	if networkSecurityGroup.FreeformTags != nil {
-		tags = map[string]interface{}{}
-		for k, v := range networkSecurityGroup.FreeformTags {
-			tags[k] = v
-		}
-	}
-
-	if networkSecurityGroup.DefinedTags != nil {
-		if tags == nil {
-			tags = map[string]interface{}{}
-		}
-		for _, v := range networkSecurityGroup.DefinedTags {
-			for key, value := range v {
-				tags[key] = value
-			}
-
-		}
-	}
-
-	return tags, nil
-}
-
 // Build additional filters
 func buildCoreNetworkSecurityGroupsFilters(equalQuals plugin.KeyColumnEqualsQualMap) core.ListNetworkSecurityGroupsRequest {
 	request := core.ListNetworkSecurityGroupsRequest{}
@@ -44,7 +44,7 @@ func tableCorePublicIP(_ context.Context) *plugin.Table {
 			},
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
-		Columns: []*plugin.Column{
+		Columns: CommonColumns([]*plugin.Column{
 			{
 				Name:        "display_name",
 				Description: "A user-friendly name. Does not have to be unique, and it's changeable. Avoid entering confidential information.",
@@ -117,36 +117,7 @@ func tableCorePublicIP(_ context.Context) *plugin.Table {
 				Description: ColumnDescriptionFreefromTags,
 				Type:        proto.ColumnType_JSON,
 			},
-
-			// Standard Steampipe columns
-			{
-				Name:        "tags",
-				Description: ColumnDescriptionTags,
-				Type:        proto.ColumnType_JSON,
-				Transform:   transform.From(publicIPTags),
-			},
-			{
-				Name:        "title",
-				Description: ColumnDescriptionTitle,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("DisplayName"),
-			},
-
-			// Standard OCI columns
-			{
-				Name:        "compartment_id",
-				Description: ColumnDescriptionCompartment,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("CompartmentId"),
-			},
-			{
-				Name:        "tenant_id",
-				Description: ColumnDescriptionTenant,
-				Type:        proto.ColumnType_STRING,
-				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
-				Transform:   transform.FromValue(),
-			},
-		},
+		}),
 	}
 }
 
@@ -190,7 +161,7 @@ func listCorePublicIPs(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydra
 	for pagesLeft {
 		response, err := session.VirtualNetworkClient.ListPublicIps(ctx, request)
 		if err != nil {
-			return nil, err
+			return nil, wrapServiceError(err, session, "ListPublicIps")
 		}
 
 		for _, ip := range response.Items {
@@ -239,7 +210,7 @@ func getCorePublicIP(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydrate
 
 	response, err := session.VirtualNetworkClient.GetPublicIp(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetPublicIp")
 	}
 
 	return response.PublicIp, nil
@@ -247,32 +218,6 @@ func getCorePublicIP(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydrate
 
 //// TRANSFORM FUNCTIONS
 
-func publicIPTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
-	publicIP := d.HydrateItem.(core.PublicIp)
-
-	var tags map[string]interface{}
-
-	if publicIP.FreeformTags != nil {
-		tags = map[string]interface{}{}
-		for k, v := range publicIP.FreeformTags {
-			tags[k] = v
-		}
-	}
-
-	if publicIP.DefinedTags != nil {
-		if tags == nil {
-			tags = map[string]interface{}{}
-		}
-		for _, v := range publicIP.DefinedTags {
-			for key, value := range v {
-				tags[key] = value
-			}
-		}
-	}
-
-	return tags, nil
-}
-
 // Build additional filters
 func buildCorePublicIPFilters(equalQuals plugin.KeyColumnEqualsQualMap) core.ListPublicIpsRequest {
 	request := core.ListPublicIpsRequest{}
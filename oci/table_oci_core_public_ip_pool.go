@@ -36,7 +36,7 @@ func tableCorePublicIPPool(_ context.Context) *plugin.Table {
 			},
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
-		Columns: []*plugin.Column{
+		Columns: CommonColumns([]*plugin.Column{
 			{
 				Name:        "display_name",
 				Description: "A user-friendly name. Does not have to be unique.",
@@ -77,42 +77,7 @@ func tableCorePublicIPPool(_ context.Context) *plugin.Table {
 				Description: ColumnDescriptionFreefromTags,
 				Type:        proto.ColumnType_JSON,
 			},
-
-			// Steampipe standard columns
-			{
-				Name:        "tags",
-				Description: ColumnDescriptionTags,
-				Type:        proto.ColumnType_JSON,
-				Transform:   transform.From(publicIPPoolTags),
-			},
-			{
-				Name:        "title",
-				Description: ColumnDescriptionTitle,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("DisplayName"),
-			},
-
-			// OCI standard columns
-			{
-				Name:        "region",
-				Description: ColumnDescriptionRegion,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("Id").Transform(ociRegionName),
-			},
-			{
-				Name:        "compartment_id",
-				Description: ColumnDescriptionCompartment,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("CompartmentId"),
-			},
-			{
-				Name:        "tenant_id",
-				Description: ColumnDescriptionTenant,
-				Type:        proto.ColumnType_STRING,
-				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
-				Transform:   transform.FromValue(),
-			},
-		},
+		}),
 	}
 }
 
@@ -161,7 +126,7 @@ func listCorePublicIPPools(ctx context.Context, d *plugin.QueryData, _ *plugin.H
 	for pagesLeft {
 		response, err := session.VirtualNetworkClient.ListPublicIpPools(ctx, request)
 		if err != nil {
-			return nil, err
+			return nil, wrapServiceError(err, session, "ListPublicIpPools")
 		}
 
 		for _, ipPool := range response.Items {
@@ -220,49 +185,8 @@ func getCorePublicIPPool(ctx context.Context, d *plugin.QueryData, h *plugin.Hyd
 
 	response, err := session.VirtualNetworkClient.GetPublicIpPool(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetPublicIpPool")
 	}
 
 	return response.PublicIpPool, nil
 }
-
-//// TRANSFORM FUNCTIONS
-
-func publicIPPoolTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
-	var freeformTags map[string]string
-	var definedTags map[string]map[string]interface{}
-
-	switch d.HydrateItem.(type) {
-	case core.PublicIpPoolSummary:
-		publicIPPool := d.HydrateItem.(core.PublicIpPoolSummary)
-		freeformTags = publicIPPool.FreeformTags
-		definedTags = publicIPPool.DefinedTags
-	case core.PublicIpPool:
-		publicIPPool := d.HydrateItem.(core.PublicIpPool)
-		freeformTags = publicIPPool.FreeformTags
-		definedTags = publicIPPool.DefinedTags
-	}
-
-	var tags map[string]interface{}
-
-	if freeformTags != nil {
-		tags = map[string]interface{}{}
-		for k, v := range freeformTags {
-			tags[k] = v
-		}
-	}
-
-	if definedTags != nil {
-		if tags == nil {
-			tags = map[string]interface{}{}
-		}
-		for _, v := range definedTags {
-			for key, value := range v {
-				tags[key] = value
-			}
-
-		}
-	}
-
-	return tags, nil
-}
@@ -44,7 +44,7 @@ func tableCoreRouteTable(_ context.Context) *plugin.Table {
 			},
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
-		Columns: []*plugin.Column{
+		Columns: CommonColumns([]*plugin.Column{
 			{
 				Name:        "display_name",
 				Description: "A user-friendly name. Does not have to be unique, and it's changeable.",
@@ -93,40 +93,13 @@ func tableCoreRouteTable(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 			},
 
-			// Standard Steampipe columns
-			{
-				Name:        "tags",
-				Description: ColumnDescriptionTags,
-				Type:        proto.ColumnType_JSON,
-				Transform:   transform.From(routeTableTags),
-			},
-			{
-				Name:        "title",
-				Description: ColumnDescriptionTitle,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("DisplayName"),
-			},
-
 			// Standard OCI columns
 			{
 				Name:        "region",
 				Description: ColumnDescriptionRegion,
 				Type:        proto.ColumnType_STRING,
 			},
-			{
-				Name:        "compartment_id",
-				Description: ColumnDescriptionCompartment,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("CompartmentId"),
-			},
-			{
-				Name:        "tenant_id",
-				Description: ColumnDescriptionTenant,
-				Type:        proto.ColumnType_STRING,
-				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
-				Transform:   transform.FromValue(),
-			},
-		},
+		}),
 	}
 }
 
@@ -175,7 +148,7 @@ func listCoreRouteTables(ctx context.Context, d *plugin.QueryData, _ *plugin.Hyd
 	for pagesLeft {
 		response, err := session.VirtualNetworkClient.ListRouteTables(ctx, request)
 		if err != nil {
-			return nil, err
+			return nil, wrapServiceError(err, session, "ListRouteTables")
 		}
 
 		for _, routeTable := range response.Items {
@@ -232,7 +205,7 @@ func getCoreRouteTable(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydra
 
 	response, err := session.VirtualNetworkClient.GetRouteTable(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetRouteTable")
 	}
 
 	return routeTableInfo{response.RouteTable, region}, nil
@@ -240,33 +213,6 @@ func getCoreRouteTable(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydra
 
 //// TRANSFORM FUNCTION
 
-func routeTableTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
-	routeTable := d.HydrateItem.(routeTableInfo).RouteTable
-
-	var tags map[string]interface{}
-
-	if routeTable.FreeformTags != nil {
-		tags = map[string]interface{}{}
-		for k, v := range routeTable.FreeformTags {
-			tags[k] = v
-		}
-	}
-
-	if routeTable.DefinedTags != nil {
-		if tags == nil {
-			tags = map[string]interface{}{}
-		}
-		for _, v := range routeTable.DefinedTags {
-			for key, value := range v {
-				tags[key] = value
-			}
-
-		}
-	}
-
-	return tags, nil
-}
-
 // Build additional filters
 func buildCoreRouteTableFilters(equalQuals plugin.KeyColumnEqualsQualMap) core.ListRouteTablesRequest {
 	request := core.ListRouteTablesRequest{}
@@ -44,7 +44,7 @@ func tableCoreSecurityList(_ context.Context) *plugin.Table {
 			},
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
-		Columns: []*plugin.Column{
+		Columns: CommonColumns([]*plugin.Column{
 			{
 				Name:        "display_name",
 				Description: "A user-friendly name. Does not have to be unique, and it's changeable.",
@@ -95,42 +95,7 @@ func tableCoreSecurityList(_ context.Context) *plugin.Table {
 				Description: ColumnDescriptionFreefromTags,
 				Type:        proto.ColumnType_JSON,
 			},
-
-			// Standard Steampipe columns
-			{
-				Name:        "tags",
-				Description: ColumnDescriptionTags,
-				Type:        proto.ColumnType_JSON,
-				Transform:   transform.From(securityListTags),
-			},
-			{
-				Name:        "title",
-				Description: ColumnDescriptionTitle,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("DisplayName"),
-			},
-
-			// Standard OCI columns
-			{
-				Name:        "region",
-				Description: ColumnDescriptionRegion,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("Id").Transform(ociRegionName),
-			},
-			{
-				Name:        "compartment_id",
-				Description: ColumnDescriptionCompartment,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("CompartmentId"),
-			},
-			{
-				Name:        "tenant_id",
-				Description: ColumnDescriptionTenant,
-				Type:        proto.ColumnType_STRING,
-				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
-				Transform:   transform.FromValue(),
-			},
-		},
+		}),
 	}
 }
 
@@ -174,7 +139,7 @@ func listCoreSecurityLists(ctx context.Context, d *plugin.QueryData, _ *plugin.H
 	for pagesLeft {
 		response, err := session.VirtualNetworkClient.ListSecurityLists(ctx, request)
 		if err != nil {
-			return nil, err
+			return nil, wrapServiceError(err, session, "ListSecurityLists")
 		}
 
 		for _, securityList := range response.Items {
@@ -230,7 +195,7 @@ func getCoreSecurityList(ctx context.Context, d *plugin.QueryData, _ *plugin.Hyd
 
 	response, err := session.VirtualNetworkClient.GetSecurityList(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetSecurityList")
 	}
 
 	return response.SecurityList, nil
@@ -238,33 +203,6 @@ func getCoreSecurityList(ctx context.Context, d *plugin.QueryData, _ *plugin.Hyd
 
 //// TRANSFORM FUNCTION
 
-func securityListTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
-	securityList := d.HydrateItem.(core.SecurityList)
-
-	var tags map[string]interface{}
-
-	if securityList.FreeformTags != nil {
-		tags = map[string]interface{}{}
-		for k, v := range securityList.FreeformTags {
-			tags[k] = v
-		}
-	}
-
-	if securityList.DefinedTags != nil {
-		if tags == nil {
-			tags = map[string]interface{}{}
-		}
-		for _, v := range securityList.DefinedTags {
-			for key, value := range v {
-				tags[key] = value
-			}
-
-		}
-	}
-
-	return tags, nil
-}
-
 // Build additional filters
 func buildCoreSecurityListFilters(equalQuals plugin.KeyColumnEqualsQualMap) core.ListSecurityListsRequest {
 	request := core.ListSecurityListsRequest{}
@@ -40,7 +40,7 @@ func tableCoreServiceGateway(_ context.Context) *plugin.Table {
 			},
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
-		Columns: []*plugin.Column{
+		Columns: CommonColumns([]*plugin.Column{
 			{
 				Name:        "id",
 				Description: "The OCID of the service gateway.",
@@ -99,42 +99,7 @@ func tableCoreServiceGateway(_ context.Context) *plugin.Table {
 				Description: ColumnDescriptionFreefromTags,
 				Type:        proto.ColumnType_JSON,
 			},
-
-			// Standard Steampipe columns
-			{
-				Name:        "tags",
-				Description: ColumnDescriptionTags,
-				Type:        proto.ColumnType_JSON,
-				Transform:   transform.From(serviceGatewayTags),
-			},
-			{
-				Name:        "title",
-				Description: ColumnDescriptionTitle,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("DisplayName"),
-			},
-
-			// Standard OCI columns
-			{
-				Name:        "region",
-				Description: ColumnDescriptionRegion,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("Id").Transform(ociRegionName),
-			},
-			{
-				Name:        "compartment_id",
-				Description: ColumnDescriptionCompartment,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("CompartmentId"),
-			},
-			{
-				Name:        "tenant_id",
-				Description: ColumnDescriptionTenant,
-				Type:        proto.ColumnType_STRING,
-				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
-				Transform:   transform.FromValue(),
-			},
-		},
+		}),
 	}
 }
 
@@ -189,7 +154,7 @@ func listCoreServiceGateways(ctx context.Context, d *plugin.QueryData, _ *plugin
 	for pagesLeft {
 		response, err := session.VirtualNetworkClient.ListServiceGateways(ctx, request)
 		if err != nil {
-			return nil, err
+			return nil, wrapServiceError(err, session, "ListServiceGateways")
 		}
 
 		for _, serviceGateway := range response.Items {
@@ -245,36 +210,8 @@ func getCoreServiceGateway(ctx context.Context, d *plugin.QueryData, _ *plugin.H
 
 	response, err := session.VirtualNetworkClient.GetServiceGateway(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetServiceGateway")
 	}
 
 	return response.ServiceGateway, nil
 }
-
-//// TRANSFORM FUNCTION
-
-func serviceGatewayTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
-	serviceGateway := d.HydrateItem.(core.ServiceGateway)
-
-	var tags map[string]interface{}
-
-	if serviceGateway.FreeformTags != nil {
-		tags = map[string]interface{}{}
-		for k, v := range serviceGateway.FreeformTags {
-			tags[k] = v
-		}
-	}
-
-	if serviceGateway.DefinedTags != nil {
-		if tags == nil {
-			tags = map[string]interface{}{}
-		}
-		for _, v := range serviceGateway.DefinedTags {
-			for key, value := range v {
-				tags[key] = value
-			}
-		}
-	}
-
-	return tags, nil
-}
@@ -44,7 +44,7 @@ func tableCoreSubnet(_ context.Context) *plugin.Table {
 			},
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
-		Columns: []*plugin.Column{
+		Columns: CommonColumns([]*plugin.Column{
 			{
 				Name:        "display_name",
 				Description: "A user-friendly name. Does not have to be unique, and it's changeable.",
@@ -158,42 +158,7 @@ func tableCoreSubnet(_ context.Context) *plugin.Table {
 				Description: ColumnDescriptionFreefromTags,
 				Type:        proto.ColumnType_JSON,
 			},
-
-			// Standard Steampipe columns
-			{
-				Name:        "tags",
-				Description: ColumnDescriptionTags,
-				Type:        proto.ColumnType_JSON,
-				Transform:   transform.From(subnetTags),
-			},
-			{
-				Name:        "title",
-				Description: ColumnDescriptionTitle,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("DisplayName"),
-			},
-
-			// Standard OCI columns
-			{
-				Name:        "region",
-				Description: ColumnDescriptionRegion,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("Id").Transform(ociRegionName),
-			},
-			{
-				Name:        "compartment_id",
-				Description: ColumnDescriptionCompartment,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("CompartmentId"),
-			},
-			{
-				Name:        "tenant_id",
-				Description: ColumnDescriptionTenant,
-				Type:        proto.ColumnType_STRING,
-				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
-				Transform:   transform.FromValue(),
-			},
-		},
+		}),
 	}
 }
 
@@ -237,7 +202,7 @@ func listCoreSubnets(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydrate
 	for pagesLeft {
 		response, err := session.VirtualNetworkClient.ListSubnets(ctx, request)
 		if err != nil {
-			return nil, err
+			return nil, wrapServiceError(err, session, "ListSubnets")
 		}
 
 		for _, subnet := range response.Items {
@@ -293,7 +258,7 @@ func getCoreSubnet(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateDa
 
 	response, err := session.VirtualNetworkClient.GetSubnet(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetSubnet")
 	}
 
 	return response.Subnet, nil
@@ -301,33 +266,6 @@ func getCoreSubnet(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateDa
 
 //// TRANSFORM FUNCTION
 
-func subnetTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
-	subnet := d.HydrateItem.(core.Subnet)
-
-	var tags map[string]interface{}
-
-	if subnet.FreeformTags != nil {
-		tags = map[string]interface{}{}
-		for k, v := range subnet.FreeformTags {
-			tags[k] = v
-		}
-	}
-
-	if subnet.DefinedTags != nil {
-		if tags == nil {
-			tags = map[string]interface{}{}
-		}
-		for _, v := range subnet.DefinedTags {
-			for key, value := range v {
-				tags[key] = value
-			}
-
-		}
-	}
-
-	return tags, nil
-}
-
 // Build additional filters
 func buildCoreSubnetFilters(equalQuals plugin.KeyColumnEqualsQualMap) core.ListSubnetsRequest {
 	request := core.ListSubnetsRequest{}
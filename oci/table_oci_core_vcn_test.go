@@ -0,0 +1,97 @@
+package oci
+
+import (
+	"sort"
+	"testing"
+)
+
+func TestCoreVcnList(t *testing.T) {
+	rows, server, err := queryTable(t, "testdata/core_vcn", "oci_core_vcn", []string{"id", "display_name", "compartment_id", "region", "tags"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the VCNs of the tenancy are listed from two pages, the VCN of the dev compartment from one
+	ids := []string{}
+	for _, row := range rows {
+		ids = append(ids, row["id"].GetStringValue())
+	}
+	sort.Strings(ids)
+	want := []string{"ocid1.vcn.oc1.iad.aaaaaaaa1", "ocid1.vcn.oc1.iad.aaaaaaaa2", "ocid1.vcn.oc1.iad.aaaaaaaa3"}
+	if len(ids) != len(want) {
+		t.Fatalf("listed VCNs %v, want %v", ids, want)
+	}
+	for i := range want {
+		if ids[i] != want[i] {
+			t.Errorf("listed VCNs %v, want %v", ids, want)
+			break
+		}
+	}
+
+	nextPageRequests := 0
+	for _, request := range server.Requests() {
+		if request.Path == "/20160918/vcns" && request.Query.Get("page") == "1" {
+			nextPageRequests++
+		}
+		if !request.Signed {
+			t.Errorf("request %s %s is not signed", request.Method, request.Path)
+		}
+	}
+	if nextPageRequests != 1 {
+		t.Errorf("requested the next page %d times, want 1", nextPageRequests)
+	}
+	if unmatched := server.Unmatched(); len(unmatched) > 0 {
+		t.Errorf("requests without fixture: %v", unmatched)
+	}
+
+	for _, row := range rows {
+		if region := row["region"].GetStringValue(); region != "us-ashburn-1" {
+			t.Errorf("region of %s = %s, want us-ashburn-1", row["id"].GetStringValue(), region)
+		}
+	}
+}
+
+func TestCoreVcnGet(t *testing.T) {
+	rows, _, err := queryTable(t, "testdata/core_vcn", "oci_core_vcn", []string{"id", "display_name", "cidr_block", "compartment_id", "tags"}, map[string]string{"id": "ocid1.vcn.oc1.iad.aaaaaaaa1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 1 {
+		t.Fatalf("got %d rows, want 1", len(rows))
+	}
+
+	row := rows[0]
+	if name := row["display_name"].GetStringValue(); name != "vcn-1" {
+		t.Errorf("display_name = %s, want vcn-1", name)
+	}
+	if cidr := row["cidr_block"].GetCidrRangeValue(); cidr != "10.0.0.0/16" {
+		t.Errorf("cidr_block = %s, want 10.0.0.0/16", cidr)
+	}
+	if compartment := row["compartment_id"].GetStringValue(); compartment != "ocid1.tenancy.oc1..aaaaaaaafake" {
+		t.Errorf("compartment_id = %s, want the tenancy", compartment)
+	}
+	if tags := string(row["tags"].GetJsonValue()); tags != `{"team":"network"}` {
+		t.Errorf("tags = %s, want the freeform tags", tags)
+	}
+}
+
+func TestCoreVcnGetNotFound(t *testing.T) {
+	rows, server, err := queryTable(t, "testdata/core_vcn", "oci_core_vcn", []string{"id", "display_name"}, map[string]string{"id": "ocid1.vcn.oc1.iad.aaaaaaaamissing"})
+	if err != nil {
+		t.Fatalf("a missing VCN returned an error: %v", err)
+	}
+	if len(rows) != 0 {
+		t.Errorf("got %d rows, want none", len(rows))
+	}
+
+	// the 404 of the service is ignored by isNotFoundError
+	requested := false
+	for _, request := range server.Requests() {
+		if request.Path == "/20160918/vcns/ocid1.vcn.oc1.iad.aaaaaaaamissing" {
+			requested = true
+		}
+	}
+	if !requested {
+		t.Error("the missing VCN was not requested")
+	}
+}
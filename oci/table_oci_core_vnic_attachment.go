@@ -25,8 +25,8 @@ func tableCoreVnicAttachment(_ context.Context) *plugin.Table {
 			Hydrate:    getVnicAttachment,
 		},
 		List: &plugin.ListConfig{
-			Hydrate:           listVnicAttachments,
-			ShouldIgnoreError: isNotFoundError([]string{"404"}),
+			Hydrate:      listVnicAttachments,
+			IgnoreConfig: &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: isNotFoundError([]string{"404"})},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "availability_domain",
@@ -43,7 +43,7 @@ func tableCoreVnicAttachment(_ context.Context) *plugin.Table {
 			},
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
-		Columns: []*plugin.Column{
+		Columns: CommonColumns([]*plugin.Column{
 			{
 				Name:        "id",
 				Description: "The OCID of the VNIC attachment.",
@@ -183,36 +183,9 @@ func tableCoreVnicAttachment(_ context.Context) *plugin.Table {
 				Description: ColumnDescriptionTags,
 				Type:        proto.ColumnType_JSON,
 				Hydrate:     getVnic,
-				Transform:   transform.From(vnicTags),
+				Transform:   transform.From(commonTags),
 			},
-			{
-				Name:        "title",
-				Description: ColumnDescriptionTitle,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("DisplayName"),
-			},
-
-			// Standard OCI columns
-			{
-				Name:        "region",
-				Description: ColumnDescriptionRegion,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("Id").Transform(ociRegionName),
-			},
-			{
-				Name:        "compartment_id",
-				Description: ColumnDescriptionCompartment,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("CompartmentId"),
-			},
-			{
-				Name:        "tenant_id",
-				Description: ColumnDescriptionTenant,
-				Type:        proto.ColumnType_STRING,
-				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
-				Transform:   transform.FromValue(),
-			},
-		},
+		}),
 	}
 }
 
@@ -272,7 +245,7 @@ func listVnicAttachments(ctx context.Context, d *plugin.QueryData, h *plugin.Hyd
 		response, err := session.ComputeClient.ListVnicAttachments(ctx, request)
 		if err != nil {
 			logger.Error("listVnicAttachments", "list_vnic_attachments_error", err)
-			return nil, err
+			return nil, wrapServiceError(err, session, "ListVnicAttachments")
 		}
 
 		for _, attachment := range response.Items {
@@ -329,7 +302,7 @@ func getVnicAttachment(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydra
 	response, err := session.ComputeClient.GetVnicAttachment(ctx, request)
 	if err != nil {
 		logger.Error("getVnicAttachment", "get_vnic_attachment_error", err)
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetVnicAttachment")
 	}
 
 	return response.VnicAttachment, nil
@@ -380,43 +353,8 @@ func getVnic(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (i
 				return nil, nil
 			}
 		}
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetVnic")
 	}
 
 	return response.Vnic, nil
 }
-
-//// TRANSFORM FUNCTION
-
-// Priority order for tags
-// 1. Defined Tags
-// 2. Free-form tags
-func vnicTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
-	if d.Value != nil {
-		vnic := d.HydrateItem.(core.Vnic)
-
-		var tags map[string]interface{}
-
-		if vnic.FreeformTags != nil {
-			tags = map[string]interface{}{}
-			for k, v := range vnic.FreeformTags {
-				tags[k] = v
-			}
-		}
-
-		if vnic.DefinedTags != nil {
-			if tags == nil {
-				tags = map[string]interface{}{}
-			}
-			for _, v := range vnic.DefinedTags {
-				for key, value := range v {
-					tags[key] = value
-				}
-			}
-		}
-
-		return tags, nil
-	}
-
-	return nil, nil
-}
@@ -48,7 +48,7 @@ func tableCoreVolume(_ context.Context) *plugin.Table {
 			},
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
-		Columns: []*plugin.Column{
+		Columns: CommonColumns([]*plugin.Column{
 			{
 				Name:        "id",
 				Description: "The OCID of the volume.",
@@ -163,40 +163,13 @@ func tableCoreVolume(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 			},
 
-			// Standard Steampipe columns
-			{
-				Name:        "tags",
-				Description: ColumnDescriptionTags,
-				Type:        proto.ColumnType_JSON,
-				Transform:   transform.From(volumeTags),
-			},
-			{
-				Name:        "title",
-				Description: ColumnDescriptionTitle,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("DisplayName"),
-			},
-
 			// Standard OCI columns
 			{
 				Name:        "region",
 				Description: ColumnDescriptionRegion,
 				Type:        proto.ColumnType_STRING,
 			},
-			{
-				Name:        "compartment_id",
-				Description: "ColumnDescriptionCompartment",
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("CompartmentId"),
-			},
-			{
-				Name:        "tenant_id",
-				Description: ColumnDescriptionTenant,
-				Type:        proto.ColumnType_STRING,
-				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
-				Transform:   transform.FromValue(),
-			},
-		},
+		}),
 	}
 }
 
@@ -245,7 +218,7 @@ func listCoreVolumes(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydrate
 	for pagesLeft {
 		response, err := session.BlockstorageClient.ListVolumes(ctx, request)
 		if err != nil {
-			return nil, err
+			return nil, wrapServiceError(err, session, "ListVolumes")
 		}
 
 		for _, volumes := range response.Items {
@@ -301,7 +274,7 @@ func getCoreVolume(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateDa
 
 	response, err := session.BlockstorageClient.GetVolume(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetVolume")
 	}
 
 	return volumeInfo{response.Volume, region}, nil
@@ -329,7 +302,7 @@ func getVolumeBackupPolicyAssignment(ctx context.Context, d *plugin.QueryData, h
 	response, err := session.BlockstorageClient.GetVolumeBackupPolicyAssetAssignment(ctx, request)
 	if err != nil {
 		plugin.Logger(ctx).Error("getVolumeBackupPolicyAssignment", "err", err)
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetVolumeBackupPolicyAssetAssignment")
 	}
 
 	if len(response.Items) > 0 {
@@ -341,49 +314,6 @@ func getVolumeBackupPolicyAssignment(ctx context.Context, d *plugin.QueryData, h
 
 //// TRANSFORM FUNCTION
 
-// Priority order for tags
-// 1. System Tags
-// 2. Defined Tags
-// 3. Free-form tags
-func volumeTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
-	volume := d.HydrateItem.(volumeInfo).Volume
-
-	var tags map[string]interface{}
-
-	if volume.FreeformTags != nil {
-		tags = map[string]interface{}{}
-		for k, v := range volume.FreeformTags {
-			tags[k] = v
-		}
-	}
-
-	if volume.DefinedTags != nil {
-		if tags == nil {
-			tags = map[string]interface{}{}
-		}
-		for _, v := range volume.DefinedTags {
-			for key, value := range v {
-				tags[key] = value
-			}
-
-		}
-	}
-
-	if volume.SystemTags != nil {
-		if tags == nil {
-			tags = map[string]interface{}{}
-		}
-		for _, v := range volume.SystemTags {
-			for key, value := range v {
-				tags[key] = value
-			}
-
-		}
-	}
-
-	return tags, nil
-}
-
 // Build additional filters
 func buildCoreVolumeFilters(equalQuals plugin.KeyColumnEqualsQualMap) core.ListVolumesRequest {
 	request := core.ListVolumesRequest{}
@@ -44,7 +44,7 @@ func tableCoreVolumeAttachment(_ context.Context) *plugin.Table {
 			},
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
-		Columns: []*plugin.Column{
+		Columns: CommonColumns([]*plugin.Column{
 			{
 				Name:        "id",
 				Description: "The OCID of the volume attachment.",
@@ -153,14 +153,7 @@ func tableCoreVolumeAttachment(_ context.Context) *plugin.Table {
 				Hydrate:     getCoreVolumeAttachmentFields,
 				Transform:   transform.FromField("CompartmentId"),
 			},
-			{
-				Name:        "tenant_id",
-				Description: ColumnDescriptionTenant,
-				Type:        proto.ColumnType_STRING,
-				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
-				Transform:   transform.FromValue(),
-			},
-		},
+		}),
 	}
 }
 
@@ -259,7 +252,7 @@ func listCoreVolumeAttachments(ctx context.Context, d *plugin.QueryData, _ *plug
 	for pagesLeft {
 		response, err := session.ComputeClient.ListVolumeAttachments(ctx, request)
 		if err != nil {
-			return nil, err
+			return nil, wrapServiceError(err, session, "ListVolumeAttachments")
 		}
 
 		for _, volumeAttachment := range response.Items {
@@ -316,7 +309,7 @@ func getCoreVolumeAttachment(ctx context.Context, d *plugin.QueryData, _ *plugin
 
 	response, err := session.ComputeClient.GetVolumeAttachment(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetVolumeAttachment")
 	}
 
 	return response.VolumeAttachment, nil
@@ -48,7 +48,7 @@ func tableCoreVolumeBackup(_ context.Context) *plugin.Table {
 			},
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
-		Columns: []*plugin.Column{
+		Columns: CommonColumns([]*plugin.Column{
 			{
 				Name:        "id",
 				Description: "The OCID of the volume backup.",
@@ -152,42 +152,7 @@ func tableCoreVolumeBackup(_ context.Context) *plugin.Table {
 				Description: "System tags to volume by the service.",
 				Type:        proto.ColumnType_JSON,
 			},
-
-			// Standard Steampipe columns
-			{
-				Name:        "tags",
-				Description: ColumnDescriptionTags,
-				Type:        proto.ColumnType_JSON,
-				Transform:   transform.From(volumeBackupTags),
-			},
-			{
-				Name:        "title",
-				Description: ColumnDescriptionTitle,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("DisplayName"),
-			},
-
-			// Standard OCI columns
-			{
-				Name:        "region",
-				Description: ColumnDescriptionRegion,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("Id").Transform(ociRegionName),
-			},
-			{
-				Name:        "compartment_id",
-				Description: ColumnDescriptionCompartment,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("CompartmentId"),
-			},
-			{
-				Name:        "tenant_id",
-				Description: ColumnDescriptionTenant,
-				Type:        proto.ColumnType_STRING,
-				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
-				Transform:   transform.FromValue(),
-			},
-		},
+		}),
 	}
 }
 
@@ -231,7 +196,7 @@ func listCoreVolumeBackups(ctx context.Context, d *plugin.QueryData, _ *plugin.H
 	for pagesLeft {
 		response, err := session.BlockstorageClient.ListVolumeBackups(ctx, request)
 		if err != nil {
-			return nil, err
+			return nil, wrapServiceError(err, session, "ListVolumeBackups")
 		}
 
 		for _, volumeBackups := range response.Items {
@@ -288,7 +253,7 @@ func getVolumeBackup(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydrate
 
 	response, err := session.BlockstorageClient.GetVolumeBackup(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetVolumeBackup")
 	}
 
 	return response.VolumeBackup, nil
@@ -296,49 +261,6 @@ func getVolumeBackup(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydrate
 
 //// TRANSFORM FUNCTION
 
-// Priority order for tags
-// 1. System Tags
-// 2. Defined Tags
-// 3. Free-form tags
-func volumeBackupTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
-	volumeBackup := d.HydrateItem.(core.VolumeBackup)
-
-	var tags map[string]interface{}
-
-	if volumeBackup.FreeformTags != nil {
-		tags = map[string]interface{}{}
-		for k, v := range volumeBackup.FreeformTags {
-			tags[k] = v
-		}
-	}
-
-	if volumeBackup.DefinedTags != nil {
-		if tags == nil {
-			tags = map[string]interface{}{}
-		}
-		for _, v := range volumeBackup.DefinedTags {
-			for key, value := range v {
-				tags[key] = value
-			}
-
-		}
-	}
-
-	if volumeBackup.SystemTags != nil {
-		if tags == nil {
-			tags = map[string]interface{}{}
-		}
-		for _, v := range volumeBackup.SystemTags {
-			for key, value := range v {
-				tags[key] = value
-			}
-
-		}
-	}
-
-	return tags, nil
-}
-
 // Build additional filters
 func buildCoreVolumeBackupFilters(equalQuals plugin.KeyColumnEqualsQualMap) core.ListVolumeBackupsRequest {
 	request := core.ListVolumeBackupsRequest{}
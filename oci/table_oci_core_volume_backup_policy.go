@@ -32,7 +32,7 @@ func tableCoreVolumeBackupPolicy(_ context.Context) *plugin.Table {
 			},
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
-		Columns: []*plugin.Column{
+		Columns: CommonColumns([]*plugin.Column{
 			{
 				Name:        "display_name",
 				Description: "A user-friendly name for volume backup policy.",
@@ -77,42 +77,7 @@ func tableCoreVolumeBackupPolicy(_ context.Context) *plugin.Table {
 				Description: ColumnDescriptionFreefromTags,
 				Type:        proto.ColumnType_JSON,
 			},
-
-			// Standard Steampipe columns
-			{
-				Name:        "tags",
-				Description: ColumnDescriptionTags,
-				Type:        proto.ColumnType_JSON,
-				Transform:   transform.From(volumeBackupPolicyTags),
-			},
-			{
-				Name:        "title",
-				Description: ColumnDescriptionTitle,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("DisplayName"),
-			},
-
-			// Standard OCI columns
-			{
-				Name:        "region",
-				Description: ColumnDescriptionRegion,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("Id").Transform(ociRegionName),
-			},
-			{
-				Name:        "compartment_id",
-				Description: ColumnDescriptionCompartment,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("CompartmentId"),
-			},
-			{
-				Name:        "tenant_id",
-				Description: ColumnDescriptionTenant,
-				Type:        proto.ColumnType_STRING,
-				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
-				Transform:   transform.FromValue(),
-			},
-		},
+		}),
 	}
 }
 
@@ -157,7 +122,7 @@ func listCoreVolumeBackupPolicies(ctx context.Context, d *plugin.QueryData, _ *p
 	for pagesLeft {
 		response, err := session.BlockstorageClient.ListVolumeBackupPolicies(ctx, request)
 		if err != nil {
-			return nil, err
+			return nil, wrapServiceError(err, session, "ListVolumeBackupPolicies")
 		}
 
 		for _, volumes := range response.Items {
@@ -214,41 +179,8 @@ func getCoreVolumeBackupPolicy(ctx context.Context, d *plugin.QueryData, _ *plug
 
 	response, err := session.BlockstorageClient.GetVolumeBackupPolicy(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetVolumeBackupPolicy")
 	}
 
 	return response.VolumeBackupPolicy, nil
 }
-
-//// TRANSFORM FUNCTION
-
-// Priority order for tags
-// 1. Free-form tags
-// 2. Defined Tags
-
-func volumeBackupPolicyTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
-	volumeBackupPolicy := d.HydrateItem.(core.VolumeBackupPolicy)
-
-	var tags map[string]interface{}
-
-	if volumeBackupPolicy.FreeformTags != nil {
-		tags = map[string]interface{}{}
-		for k, v := range volumeBackupPolicy.FreeformTags {
-			tags[k] = v
-		}
-	}
-
-	if volumeBackupPolicy.DefinedTags != nil {
-		if tags == nil {
-			tags = map[string]interface{}{}
-		}
-		for _, v := range volumeBackupPolicy.DefinedTags {
-			for key, value := range v {
-				tags[key] = value
-			}
-
-		}
-	}
-
-	return tags, nil
-}
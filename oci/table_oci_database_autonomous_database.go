@@ -66,7 +66,7 @@ func tableOciDatabaseAutonomousDatabase(_ context.Context) *plugin.Table {
 			},
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
-		Columns: []*plugin.Column{
+		Columns: CommonColumns([]*plugin.Column{
 			{
 				Name:        "display_name",
 				Description: "The user-friendly name for the Autonomous Database. The name does not have to be unique.",
@@ -388,42 +388,7 @@ func tableOciDatabaseAutonomousDatabase(_ context.Context) *plugin.Table {
 				Description: ColumnDescriptionSystemTags,
 				Type:        proto.ColumnType_JSON,
 			},
-
-			// Steampipe standard columns
-			{
-				Name:        "tags",
-				Description: ColumnDescriptionTags,
-				Type:        proto.ColumnType_JSON,
-				Transform:   transform.From(autonomousDatabaseTags),
-			},
-			{
-				Name:        "title",
-				Description: ColumnDescriptionTitle,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("DisplayName"),
-			},
-
-			// OCI standard columns
-			{
-				Name:        "region",
-				Description: ColumnDescriptionRegion,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("Id").Transform(ociRegionName),
-			},
-			{
-				Name:        "compartment_id",
-				Description: ColumnDescriptionCompartment,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("CompartmentId"),
-			},
-			{
-				Name:        "tenant_id",
-				Description: ColumnDescriptionTenant,
-				Type:        proto.ColumnType_STRING,
-				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
-				Transform:   transform.FromValue(),
-			},
-		},
+		}),
 	}
 }
 
@@ -468,7 +433,7 @@ func listAutonomousDatabases(ctx context.Context, d *plugin.QueryData, _ *plugin
 	for pagesLeft {
 		response, err := session.DatabaseClient.ListAutonomousDatabases(ctx, request)
 		if err != nil {
-			return nil, err
+			return nil, wrapServiceError(err, session, "ListAutonomousDatabases")
 		}
 
 		for _, database := range response.Items {
@@ -524,7 +489,7 @@ func getAutonomousDatabase(ctx context.Context, d *plugin.QueryData, _ *plugin.H
 
 	response, err := session.DatabaseClient.GetAutonomousDatabase(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetAutonomousDatabase")
 	}
 
 	return response.AutonomousDatabase, nil
@@ -532,60 +497,6 @@ func getAutonomousDatabase(ctx context.Context, d *plugin.QueryData, _ *plugin.H
 
 //// TRANSFORM FUNCTION
 
-func autonomousDatabaseTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
-	var freeformTags map[string]string
-	var definedTags map[string]map[string]interface{}
-	var systemTags map[string]map[string]interface{}
-
-	switch d.HydrateItem.(type) {
-	case database.AutonomousDatabaseSummary:
-		database := d.HydrateItem.(database.AutonomousDatabaseSummary)
-		freeformTags = database.FreeformTags
-		definedTags = database.DefinedTags
-		systemTags = database.SystemTags
-	case database.AutonomousDatabase:
-		database := d.HydrateItem.(database.AutonomousDatabase)
-		freeformTags = database.FreeformTags
-		definedTags = database.DefinedTags
-		systemTags = database.SystemTags
-	}
-
-	var tags map[string]interface{}
-
-	if freeformTags != nil {
-		tags = map[string]interface{}{}
-		for k, v := range freeformTags {
-			tags[k] = v
-		}
-	}
-
-	if definedTags != nil {
-		if tags == nil {
-			tags = map[string]interface{}{}
-		}
-		for _, v := range definedTags {
-			for key, value := range v {
-				tags[key] = value
-			}
-
-		}
-	}
-
-	if systemTags != nil {
-		if tags == nil {
-			tags = map[string]interface{}{}
-		}
-		for _, v := range systemTags {
-			for key, value := range v {
-				tags[key] = value
-			}
-
-		}
-	}
-
-	return tags, nil
-}
-
 func buildAutonomousDatabaseFilter(equalQuals plugin.KeyColumnEqualsQualMap, quals plugin.KeyColumnQualMap) database.ListAutonomousDatabasesRequest {
 	request := database.ListAutonomousDatabasesRequest{}
 
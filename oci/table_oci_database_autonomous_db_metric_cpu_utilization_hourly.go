@@ -2,9 +2,7 @@ package oci
 
 import (
 	"context"
-	"fmt"
 
-	"github.com/oracle/oci-go-sdk/v44/database"
 	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
@@ -17,8 +15,8 @@ func tableOciDatabaseAutonomousDatabaseMetricCpuUtilizationHourly(_ context.Cont
 		Name:        "oci_database_autonomous_db_metric_cpu_utilization_hourly",
 		Description: "OCI Autonomous Database Monitoring Metrics - CPU Utilization (Hourly)",
 		List: &plugin.ListConfig{
-			ParentHydrate: listAutonomousDatabases,
-			Hydrate:       listAutonomousDatabaseMetricCpuUtilizationHourly,
+			Hydrate:    listAutonomousDatabaseMetricCpuUtilizationHourly,
+			KeyColumns: MonitoringMetricKeyColumns(),
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
 		Columns: MonitoringMetricColumns(
@@ -33,8 +31,6 @@ func tableOciDatabaseAutonomousDatabaseMetricCpuUtilizationHourly(_ context.Cont
 	}
 }
 
-func listAutonomousDatabaseMetricCpuUtilizationHourly(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
-	database := h.Item.(database.AutonomousDatabaseSummary)
-	region := fmt.Sprintf("%v", ociRegionNameFromId(*database.Id))
-	return listMonitoringMetricStatistics(ctx, d, "HOURLY", "oci_autonomous_database", "CpuUtilization", "resourceId", *database.Id, *database.CompartmentId, region)
+func listAutonomousDatabaseMetricCpuUtilizationHourly(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	return listMonitoringMetricStatistics(ctx, d, "HOURLY", "oci_autonomous_database", "CpuUtilization", "resourceId", "")
 }
@@ -2,9 +2,7 @@ package oci
 
 import (
 	"context"
-	"fmt"
 
-	"github.com/oracle/oci-go-sdk/v44/database"
 	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
@@ -17,8 +15,8 @@ func tableOciDatabaseAutonomousDatabaseMetricStorageUtilization(_ context.Contex
 		Name:        "oci_database_autonomous_db_metric_storage_utilization",
 		Description: "OCI Autonomous Database Monitoring Metrics - Storage Utilization",
 		List: &plugin.ListConfig{
-			ParentHydrate: listAutonomousDatabases,
-			Hydrate:       listAutonomousDatabaseMetricStorageUtilization,
+			Hydrate:    listAutonomousDatabaseMetricStorageUtilization,
+			KeyColumns: MonitoringMetricKeyColumns(),
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
 		Columns: MonitoringMetricColumns(
@@ -33,8 +31,6 @@ func tableOciDatabaseAutonomousDatabaseMetricStorageUtilization(_ context.Contex
 	}
 }
 
-func listAutonomousDatabaseMetricStorageUtilization(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
-	database := h.Item.(database.AutonomousDatabaseSummary)
-	region := fmt.Sprintf("%v", ociRegionNameFromId(*database.Id))
-	return listMonitoringMetricStatistics(ctx, d, "5_MIN", "oci_autonomous_database", "StorageUtilization", "resourceId", *database.Id, *database.CompartmentId, region)
+func listAutonomousDatabaseMetricStorageUtilization(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	return listMonitoringMetricStatistics(ctx, d, "5_MIN", "oci_autonomous_database", "StorageUtilization", "resourceId", "")
 }
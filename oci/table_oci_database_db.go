@@ -23,9 +23,9 @@ func tableOciDatabase(_ context.Context) *plugin.Table {
 			Hydrate:    getDatabase,
 		},
 		List: &plugin.ListConfig{
-			ShouldIgnoreError: isNotFoundError([]string{"404"}),
-			ParentHydrate:     listDatabaseDBHomes,
-			Hydrate:           listDatabases,
+			IgnoreConfig:  &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: isNotFoundError([]string{"404"})},
+			ParentHydrate: listDatabaseDBHomes,
+			Hydrate:       listDatabases,
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -42,7 +42,7 @@ func tableOciDatabase(_ context.Context) *plugin.Table {
 			},
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
-		Columns: []*plugin.Column{
+		Columns: CommonColumns([]*plugin.Column{
 			{
 				Name:        "db_name",
 				Description: "The database name.",
@@ -161,40 +161,13 @@ func tableOciDatabase(_ context.Context) *plugin.Table {
 			},
 
 			// Steampipe standard columns
-			{
-				Name:        "tags",
-				Description: ColumnDescriptionTags,
-				Type:        proto.ColumnType_JSON,
-				Transform:   transform.From(databaseTags),
-			},
 			{
 				Name:        "title",
 				Description: ColumnDescriptionTitle,
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("DbName"),
 			},
-
-			// OCI standard columns
-			{
-				Name:        "region",
-				Description: ColumnDescriptionRegion,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("Id").Transform(ociRegionName),
-			},
-			{
-				Name:        "compartment_id",
-				Description: ColumnDescriptionCompartment,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("CompartmentId"),
-			},
-			{
-				Name:        "tenant_id",
-				Description: ColumnDescriptionTenant,
-				Type:        proto.ColumnType_STRING,
-				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
-				Transform:   transform.FromValue(),
-			},
-		},
+		}),
 	}
 }
 
@@ -252,7 +225,7 @@ func listDatabases(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateDa
 	for pagesLeft {
 		response, err := session.DatabaseClient.ListDatabases(ctx, request)
 		if err != nil {
-			return nil, err
+			return nil, wrapServiceError(err, session, "ListDatabases")
 		}
 
 		for _, database := range response.Items {
@@ -308,49 +281,8 @@ func getDatabase(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData
 
 	response, err := session.DatabaseClient.GetDatabase(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetDatabase")
 	}
 
 	return response.Database, nil
 }
-
-//// TRANSFORM FUNCTION
-
-func databaseTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
-	var freeformTags map[string]string
-	var definedTags map[string]map[string]interface{}
-
-	switch d.HydrateItem.(type) {
-	case database.DatabaseSummary:
-		database := d.HydrateItem.(database.DatabaseSummary)
-		freeformTags = database.FreeformTags
-		definedTags = database.DefinedTags
-	case database.Database:
-		database := d.HydrateItem.(database.Database)
-		freeformTags = database.FreeformTags
-		definedTags = database.DefinedTags
-	}
-
-	var tags map[string]interface{}
-
-	if freeformTags != nil {
-		tags = map[string]interface{}{}
-		for k, v := range freeformTags {
-			tags[k] = v
-		}
-	}
-
-	if definedTags != nil {
-		if tags == nil {
-			tags = map[string]interface{}{}
-		}
-		for _, v := range definedTags {
-			for key, value := range v {
-				tags[key] = value
-			}
-
-		}
-	}
-
-	return tags, nil
-}
@@ -52,7 +52,7 @@ func tableOciDatabaseDBHome(_ context.Context) *plugin.Table {
 			Hydrate:    getDatabaseDBHome,
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
-		Columns: []*plugin.Column{
+		Columns: CommonColumns([]*plugin.Column{
 			{
 				Name:        "display_name",
 				Description: "The user-friendly name for the database home. It does not have to be unique.",
@@ -141,42 +141,7 @@ func tableOciDatabaseDBHome(_ context.Context) *plugin.Table {
 				Description: ColumnDescriptionFreefromTags,
 				Type:        proto.ColumnType_JSON,
 			},
-
-			// Standard Steampipe columns
-			{
-				Name:        "tags",
-				Description: ColumnDescriptionTags,
-				Type:        proto.ColumnType_JSON,
-				Transform:   transform.From(dbHomeTags),
-			},
-			{
-				Name:        "title",
-				Description: ColumnDescriptionTitle,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("DisplayName"),
-			},
-
-			// Standard OCI columns
-			{
-				Name:        "region",
-				Description: ColumnDescriptionRegion,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("Id").Transform(ociRegionName),
-			},
-			{
-				Name:        "compartment_id",
-				Description: ColumnDescriptionCompartment,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("CompartmentId"),
-			},
-			{
-				Name:        "tenant_id",
-				Description: ColumnDescriptionTenant,
-				Type:        proto.ColumnType_STRING,
-				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
-				Transform:   transform.FromValue(),
-			},
-		},
+		}),
 	}
 }
 
@@ -220,7 +185,7 @@ func listDatabaseDBHomes(ctx context.Context, d *plugin.QueryData, _ *plugin.Hyd
 	for pagesLeft {
 		response, err := session.DatabaseClient.ListDbHomes(ctx, request)
 		if err != nil {
-			return nil, err
+			return nil, wrapServiceError(err, session, "ListDbHomes")
 		}
 
 		for _, dbHome := range response.Items {
@@ -276,7 +241,7 @@ func getDatabaseDBHome(ctx context.Context, d *plugin.QueryData, h *plugin.Hydra
 
 	response, err := session.DatabaseClient.GetDbHome(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetDbHome")
 	}
 
 	return response.DbHome, nil
@@ -284,35 +249,6 @@ func getDatabaseDBHome(ctx context.Context, d *plugin.QueryData, h *plugin.Hydra
 
 //// TRANSFORM FUNCTION
 
-func dbHomeTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
-	freeformTags := dbHomeFreeformTags(d.HydrateItem)
-
-	var tags map[string]interface{}
-
-	if freeformTags != nil {
-		tags = map[string]interface{}{}
-		for k, v := range freeformTags {
-			tags[k] = v
-		}
-	}
-
-	definedTags := dbHomeDefinedTags(d.HydrateItem)
-
-	if definedTags != nil {
-		if tags == nil {
-			tags = map[string]interface{}{}
-		}
-		for _, v := range definedTags {
-			for key, value := range v {
-				tags[key] = value
-			}
-
-		}
-	}
-
-	return tags, nil
-}
-
 func dbHomeFreeformTags(item interface{}) map[string]string {
 	switch item := item.(type) {
 	case database.DbHome:
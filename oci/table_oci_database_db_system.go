@@ -44,7 +44,7 @@ func tableOciDatabaseDBSystem(_ context.Context) *plugin.Table {
 			},
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
-		Columns: []*plugin.Column{
+		Columns: CommonColumns([]*plugin.Column{
 			{
 				Name:        "display_name",
 				Description: "The user-friendly name for the DB system. The name does not have to be unique.",
@@ -279,42 +279,7 @@ func tableOciDatabaseDBSystem(_ context.Context) *plugin.Table {
 				Description: ColumnDescriptionFreefromTags,
 				Type:        proto.ColumnType_JSON,
 			},
-
-			// Steampipe standard columns
-			{
-				Name:        "tags",
-				Description: ColumnDescriptionTags,
-				Type:        proto.ColumnType_JSON,
-				Transform:   transform.From(databaseDBSystemTags),
-			},
-			{
-				Name:        "title",
-				Description: ColumnDescriptionTitle,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("DisplayName"),
-			},
-
-			// OCI standard columns
-			{
-				Name:        "region",
-				Description: ColumnDescriptionRegion,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("Id").Transform(ociRegionName),
-			},
-			{
-				Name:        "compartment_id",
-				Description: ColumnDescriptionCompartment,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("CompartmentId"),
-			},
-			{
-				Name:        "tenant_id",
-				Description: ColumnDescriptionTenant,
-				Type:        proto.ColumnType_STRING,
-				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
-				Transform:   transform.FromValue(),
-			},
-		},
+		}),
 	}
 }
 
@@ -358,7 +323,7 @@ func listDatabaseDBSystems(ctx context.Context, d *plugin.QueryData, _ *plugin.H
 	for pagesLeft {
 		response, err := session.DatabaseClient.ListDbSystems(ctx, request)
 		if err != nil {
-			return nil, err
+			return nil, wrapServiceError(err, session, "ListDbSystems")
 		}
 
 		for _, dbSystem := range response.Items {
@@ -418,7 +383,7 @@ func getDatabaseDBSystem(ctx context.Context, d *plugin.QueryData, h *plugin.Hyd
 
 	response, err := session.DatabaseClient.GetDbSystem(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetDbSystem")
 	}
 
 	return response.DbSystem, nil
@@ -426,45 +391,6 @@ func getDatabaseDBSystem(ctx context.Context, d *plugin.QueryData, h *plugin.Hyd
 
 //// TRANSFORM FUNCTION
 
-func databaseDBSystemTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
-	var freeformTags map[string]string
-	var definedTags map[string]map[string]interface{}
-
-	switch d.HydrateItem.(type) {
-	case database.DbSystemSummary:
-		database := d.HydrateItem.(database.DbSystemSummary)
-		freeformTags = database.FreeformTags
-		definedTags = database.DefinedTags
-	case database.DbSystem:
-		database := d.HydrateItem.(database.DbSystem)
-		freeformTags = database.FreeformTags
-		definedTags = database.DefinedTags
-	}
-
-	var tags map[string]interface{}
-
-	if freeformTags != nil {
-		tags = map[string]interface{}{}
-		for k, v := range freeformTags {
-			tags[k] = v
-		}
-	}
-
-	if definedTags != nil {
-		if tags == nil {
-			tags = map[string]interface{}{}
-		}
-		for _, v := range definedTags {
-			for key, value := range v {
-				tags[key] = value
-			}
-
-		}
-	}
-
-	return tags, nil
-}
-
 // Build additional filters
 func buildDatabaseDBSystemFilters(equalQuals plugin.KeyColumnEqualsQualMap) database.ListDbSystemsRequest {
 	request := database.ListDbSystemsRequest{}
@@ -21,8 +21,8 @@ func tableOciPluggableDatabase(_ context.Context) *plugin.Table {
 			Hydrate:    getPluggableDatabase,
 		},
 		List: &plugin.ListConfig{
-			ShouldIgnoreError: isNotFoundError([]string{"404"}),
-			Hydrate:           listDatabasePluggableDatabases,
+			IgnoreConfig: &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: isNotFoundError([]string{"404"})},
+			Hydrate:      listDatabasePluggableDatabases,
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -39,7 +39,7 @@ func tableOciPluggableDatabase(_ context.Context) *plugin.Table {
 			},
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
-		Columns: []*plugin.Column{
+		Columns: CommonColumns([]*plugin.Column{
 			{
 				Name:        "pdb_name",
 				Description: "The name for the pluggable database. The name is unique in the context of a Database. The name must begin with an alphabetic character and can contain a maximum of thirty alphanumeric characters. Special characters are not permitted. The pluggable database name should not be same as the container database name.",
@@ -102,40 +102,13 @@ func tableOciPluggableDatabase(_ context.Context) *plugin.Table {
 			},
 
 			// Steampipe standard columns
-			{
-				Name:        "tags",
-				Description: ColumnDescriptionTags,
-				Type:        proto.ColumnType_JSON,
-				Transform:   transform.From(pluggableDatabaseTags),
-			},
 			{
 				Name:        "title",
 				Description: ColumnDescriptionTitle,
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("PdbName"),
 			},
-
-			// OCI standard columns
-			{
-				Name:        "region",
-				Description: ColumnDescriptionRegion,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("Id").Transform(ociRegionName),
-			},
-			{
-				Name:        "compartment_id",
-				Description: ColumnDescriptionCompartment,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("CompartmentId"),
-			},
-			{
-				Name:        "tenant_id",
-				Description: ColumnDescriptionTenant,
-				Type:        proto.ColumnType_STRING,
-				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
-				Transform:   transform.FromValue(),
-			},
-		},
+		}),
 	}
 }
 
@@ -194,7 +167,7 @@ func listDatabasePluggableDatabases(ctx context.Context, d *plugin.QueryData, h
 	for pagesLeft {
 		response, err := session.DatabaseClient.ListPluggableDatabases(ctx, request)
 		if err != nil {
-			return nil, err
+			return nil, wrapServiceError(err, session, "ListPluggableDatabases")
 		}
 
 		for _, database := range response.Items {
@@ -249,51 +222,13 @@ func getPluggableDatabase(ctx context.Context, d *plugin.QueryData, _ *plugin.Hy
 
 	response, err := session.DatabaseClient.GetPluggableDatabase(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetPluggableDatabase")
 	}
 	return response.PluggableDatabase, nil
 }
 
 //// TRANSFORM FUNCTION
 
-func pluggableDatabaseTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
-	var freeformTags map[string]string
-	var definedTags map[string]map[string]interface{}
-
-	switch d.HydrateItem.(type) {
-	case database.PluggableDatabaseSummary:
-		pDatabaseSummary := d.HydrateItem.(database.PluggableDatabaseSummary)
-		freeformTags = pDatabaseSummary.FreeformTags
-		definedTags = pDatabaseSummary.DefinedTags
-	case database.PluggableDatabase:
-		pDatabase := d.HydrateItem.(database.PluggableDatabase)
-		freeformTags = pDatabase.FreeformTags
-		definedTags = pDatabase.DefinedTags
-	}
-
-	var tags map[string]interface{}
-
-	if freeformTags != nil {
-		tags = map[string]interface{}{}
-		for k, v := range freeformTags {
-			tags[k] = v
-		}
-	}
-
-	if definedTags != nil {
-		if tags == nil {
-			tags = map[string]interface{}{}
-		}
-		for _, v := range definedTags {
-			for key, value := range v {
-				tags[key] = value
-			}
-		}
-	}
-
-	return tags, nil
-}
-
 func isValidPluggableDatabaseSummaryLifecycleState(state string) bool {
 	stateType := database.PluggableDatabaseSummaryLifecycleStateEnum(state)
 	switch stateType {
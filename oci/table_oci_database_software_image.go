@@ -52,7 +52,7 @@ func tableOciDatabaseSoftwareImage(_ context.Context) *plugin.Table {
 			},
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
-		Columns: []*plugin.Column{
+		Columns: CommonColumns([]*plugin.Column{
 			{
 				Name:        "display_name",
 				Description: "The user-friendly name for the database software image. The name does not have to be unique.",
@@ -138,42 +138,7 @@ func tableOciDatabaseSoftwareImage(_ context.Context) *plugin.Table {
 				Description: ColumnDescriptionFreefromTags,
 				Type:        proto.ColumnType_JSON,
 			},
-
-			// Steampipe standard columns
-			{
-				Name:        "tags",
-				Description: ColumnDescriptionTags,
-				Type:        proto.ColumnType_JSON,
-				Transform:   transform.From(softwareImageTags),
-			},
-			{
-				Name:        "title",
-				Description: ColumnDescriptionTitle,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("DisplayName"),
-			},
-
-			// OCI standard columns
-			{
-				Name:        "region",
-				Description: ColumnDescriptionRegion,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("Id").Transform(ociRegionName),
-			},
-			{
-				Name:        "compartment_id",
-				Description: ColumnDescriptionCompartment,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("CompartmentId"),
-			},
-			{
-				Name:        "tenant_id",
-				Description: ColumnDescriptionTenant,
-				Type:        proto.ColumnType_STRING,
-				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
-				Transform:   transform.FromValue(),
-			},
-		},
+		}),
 	}
 }
 
@@ -217,7 +182,7 @@ func listSoftwareImages(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydr
 	for pagesLeft {
 		response, err := session.DatabaseClient.ListDatabaseSoftwareImages(ctx, request)
 		if err != nil {
-			return nil, err
+			return nil, wrapServiceError(err, session, "ListDatabaseSoftwareImages")
 		}
 
 		for _, image := range response.Items {
@@ -273,7 +238,7 @@ func getSoftwareImage(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydrat
 
 	response, err := session.DatabaseClient.GetDatabaseSoftwareImage(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetDatabaseSoftwareImage")
 	}
 
 	return response.DatabaseSoftwareImage, nil
@@ -281,48 +246,6 @@ func getSoftwareImage(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydrat
 
 //// TRANSFORM FUNCTION
 
-// Priority order for tags
-// 1. Defined Tags
-// 2. Free-form tags
-func softwareImageTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
-	var freeformTags map[string]string
-	var definedTags map[string]map[string]interface{}
-
-	switch d.HydrateItem.(type) {
-	case database.DatabaseSoftwareImage:
-		image := d.HydrateItem.(database.DatabaseSoftwareImage)
-		freeformTags = image.FreeformTags
-		definedTags = image.DefinedTags
-	case database.DatabaseSoftwareImageSummary:
-		image := d.HydrateItem.(database.DatabaseSoftwareImageSummary)
-		freeformTags = image.FreeformTags
-		definedTags = image.DefinedTags
-	}
-
-	var tags map[string]interface{}
-
-	if freeformTags != nil {
-		tags = map[string]interface{}{}
-		for k, v := range freeformTags {
-			tags[k] = v
-		}
-	}
-
-	if definedTags != nil {
-		if tags == nil {
-			tags = map[string]interface{}{}
-		}
-		for _, v := range definedTags {
-			for key, value := range v {
-				tags[key] = value
-			}
-
-		}
-	}
-
-	return tags, nil
-}
-
 // Build additional filters
 func buildDatabaseSoftwareImageFilters(equalQuals plugin.KeyColumnEqualsQualMap) database.ListDatabaseSoftwareImagesRequest {
 	request := database.ListDatabaseSoftwareImagesRequest{}
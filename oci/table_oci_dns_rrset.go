@@ -36,7 +36,7 @@ func tableDnsRecordSet(_ context.Context) *plugin.Table {
 			},
 		},
 		GetMatrixItemFunc: BuildCompartmentList,
-		Columns: []*plugin.Column{
+		Columns: CommonColumns([]*plugin.Column{
 			{
 				Name:        "domain",
 				Description: "The fully qualified domain name where the record can be located.",
@@ -84,22 +84,7 @@ func tableDnsRecordSet(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("Domain"),
 			},
-
-			// OCI standard columns
-			{
-				Name:        "compartment_id",
-				Description: ColumnDescriptionCompartment,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("CompartmentId"),
-			},
-			{
-				Name:        "tenant_id",
-				Description: ColumnDescriptionTenant,
-				Type:        proto.ColumnType_STRING,
-				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
-				Transform:   transform.FromValue(),
-			},
-		},
+		}),
 	}
 }
 
@@ -158,7 +143,7 @@ func listDnsRecordSets(ctx context.Context, d *plugin.QueryData, h *plugin.Hydra
 	for pagesLeft {
 		response, err := session.DnsClient.GetZoneRecords(ctx, request)
 		if err != nil {
-			return nil, err
+			return nil, wrapServiceError(err, session, "GetZoneRecords")
 		}
 
 		for _, record := range response.Items {
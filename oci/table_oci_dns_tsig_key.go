@@ -44,7 +44,7 @@ func tableDnsTsigKey(_ context.Context) *plugin.Table {
 			Hydrate:    getDnsTsigKey,
 		},
 		GetMatrixItemFunc: BuildCompartmentList,
-		Columns: []*plugin.Column{
+		Columns: CommonColumns([]*plugin.Column{
 			{
 				Name:        "name",
 				Description: "A globally unique domain name identifying the key for a given pair of hosts.",
@@ -106,34 +106,13 @@ func tableDnsTsigKey(_ context.Context) *plugin.Table {
 			},
 
 			// Standard Steampipe columns
-			{
-				Name:        "tags",
-				Description: ColumnDescriptionTags,
-				Type:        proto.ColumnType_JSON,
-				Transform:   transform.From(dnsTsigTags),
-			},
 			{
 				Name:        "title",
 				Description: ColumnDescriptionTitle,
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("Name"),
 			},
-
-			// Standard OCI columns
-			{
-				Name:        "compartment_id",
-				Description: ColumnDescriptionCompartment,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("CompartmentId"),
-			},
-			{
-				Name:        "tenant_id",
-				Description: ColumnDescriptionTenant,
-				Type:        proto.ColumnType_STRING,
-				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
-				Transform:   transform.FromValue(),
-			},
-		},
+		}),
 	}
 }
 
@@ -176,7 +155,7 @@ func listDnsTsigKeys(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydrate
 	for pagesLeft {
 		keys, err := session.DnsClient.ListTsigKeys(ctx, request)
 		if err != nil {
-			return nil, err
+			return nil, wrapServiceError(err, session, "ListTsigKeys")
 		}
 
 		for _, key := range keys.Items {
@@ -233,7 +212,7 @@ func getDnsTsigKey(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateDa
 
 	response, err := session.DnsClient.GetTsigKey(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetTsigKey")
 	}
 
 	return response.TsigKey, nil
@@ -241,48 +220,6 @@ func getDnsTsigKey(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateDa
 
 //// TRANSFORM FUNCTION
 
-// Priority order for tags
-// 2. Defined Tags
-// 3. Free-form tags
-func dnsTsigTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
-	var freeformTags map[string]string
-	var definedTags map[string]map[string]interface{}
-
-	switch d.HydrateItem.(type) {
-	case dns.TsigKey:
-		key := d.HydrateItem.(dns.TsigKey)
-		freeformTags = key.FreeformTags
-		definedTags = key.DefinedTags
-	case dns.TsigKeySummary:
-		key := d.HydrateItem.(dns.TsigKeySummary)
-		freeformTags = key.FreeformTags
-		definedTags = key.DefinedTags
-	}
-
-	var tags map[string]interface{}
-
-	if freeformTags != nil {
-		tags = map[string]interface{}{}
-		for k, v := range freeformTags {
-			tags[k] = v
-		}
-	}
-
-	if definedTags != nil {
-		if tags == nil {
-			tags = map[string]interface{}{}
-		}
-		for _, v := range definedTags {
-			for key, value := range v {
-				tags[key] = value
-			}
-
-		}
-	}
-
-	return tags, nil
-}
-
 // Build additional filters
 func buildDnsTsigKeyFilters(equalQuals plugin.KeyColumnEqualsQualMap) dns.ListTsigKeysRequest {
 	request := dns.ListTsigKeysRequest{}
@@ -52,7 +52,7 @@ func tableDnsZone(_ context.Context) *plugin.Table {
 			Hydrate:    getDnsZone,
 		},
 		GetMatrixItemFunc: BuildCompartmentList,
-		Columns: []*plugin.Column{
+		Columns: CommonColumns([]*plugin.Column{
 			{
 				Name:        "name",
 				Description: "The name of the zone.",
@@ -141,34 +141,13 @@ func tableDnsZone(_ context.Context) *plugin.Table {
 			},
 
 			// Standard Steampipe columns
-			{
-				Name:        "tags",
-				Description: ColumnDescriptionTags,
-				Type:        proto.ColumnType_JSON,
-				Transform:   transform.From(dnsZoneTags),
-			},
 			{
 				Name:        "title",
 				Description: ColumnDescriptionTitle,
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("Name"),
 			},
-
-			// Standard OCI columns
-			{
-				Name:        "compartment_id",
-				Description: ColumnDescriptionCompartment,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("CompartmentId"),
-			},
-			{
-				Name:        "tenant_id",
-				Description: ColumnDescriptionTenant,
-				Type:        proto.ColumnType_STRING,
-				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
-				Transform:   transform.FromValue(),
-			},
-		},
+		}),
 	}
 }
 
@@ -211,7 +190,7 @@ func listDnsZones(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateDat
 	for pagesLeft {
 		zones, err := session.DnsClient.ListZones(ctx, request)
 		if err != nil {
-			return nil, err
+			return nil, wrapServiceError(err, session, "ListZones")
 		}
 
 		for _, zone := range zones.Items {
@@ -268,7 +247,7 @@ func getDnsZone(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData)
 
 	response, err := session.DnsClient.GetZone(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetZone")
 	}
 
 	return response.Zone, nil
@@ -276,48 +255,6 @@ func getDnsZone(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData)
 
 //// TRANSFORM FUNCTION
 
-// Priority order for tags
-// 2. Defined Tags
-// 3. Free-form tags
-func dnsZoneTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
-	var freeformTags map[string]string
-	var definedTags map[string]map[string]interface{}
-
-	switch d.HydrateItem.(type) {
-	case dns.Zone:
-		zone := d.HydrateItem.(dns.Zone)
-		freeformTags = zone.FreeformTags
-		definedTags = zone.DefinedTags
-	case dns.ZoneSummary:
-		zone := d.HydrateItem.(dns.ZoneSummary)
-		freeformTags = zone.FreeformTags
-		definedTags = zone.DefinedTags
-	}
-
-	var tags map[string]interface{}
-
-	if freeformTags != nil {
-		tags = map[string]interface{}{}
-		for k, v := range freeformTags {
-			tags[k] = v
-		}
-	}
-
-	if definedTags != nil {
-		if tags == nil {
-			tags = map[string]interface{}{}
-		}
-		for _, v := range definedTags {
-			for key, value := range v {
-				tags[key] = value
-			}
-
-		}
-	}
-
-	return tags, nil
-}
-
 // Build additional filters
 func buildDnsZoneFilters(equalQuals plugin.KeyColumnEqualsQualMap) dns.ListZonesRequest {
 	request := dns.ListZonesRequest{}
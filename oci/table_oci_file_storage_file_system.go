@@ -19,9 +19,9 @@ func tableFileStorageFileSystem(_ context.Context) *plugin.Table {
 		Name:        "oci_file_storage_file_system",
 		Description: "OCI File Storage File System",
 		Get: &plugin.GetConfig{
-			KeyColumns:        plugin.SingleColumn("id"),
-			ShouldIgnoreError: isNotFoundError([]string{"400"}),
-			Hydrate:           getFileStorageFileSystem,
+			KeyColumns:   plugin.SingleColumn("id"),
+			IgnoreConfig: &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: isNotFoundError([]string{"400"})},
+			Hydrate:      getFileStorageFileSystem,
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listFileStorageFileSystems,
@@ -49,7 +49,7 @@ func tableFileStorageFileSystem(_ context.Context) *plugin.Table {
 			},
 		},
 		GetMatrixItemFunc: BuildCompartementZonalList,
-		Columns: []*plugin.Column{
+		Columns: CommonColumns([]*plugin.Column{
 			{
 				Name:        "display_name",
 				Description: "A user-friendly name. It does not have to be unique, and it is changeable.",
@@ -122,42 +122,7 @@ func tableFileStorageFileSystem(_ context.Context) *plugin.Table {
 				Description: ColumnDescriptionFreefromTags,
 				Type:        proto.ColumnType_JSON,
 			},
-
-			// Standard Steampipe columns
-			{
-				Name:        "tags",
-				Description: ColumnDescriptionTags,
-				Type:        proto.ColumnType_JSON,
-				Transform:   transform.From(fileSystemTags),
-			},
-			{
-				Name:        "title",
-				Description: ColumnDescriptionTitle,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("DisplayName"),
-			},
-
-			// Standard OCI columns
-			{
-				Name:        "region",
-				Description: ColumnDescriptionRegion,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("Id").Transform(ociRegionName),
-			},
-			{
-				Name:        "compartment_id",
-				Description: ColumnDescriptionCompartment,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("CompartmentId"),
-			},
-			{
-				Name:        "tenant_id",
-				Description: ColumnDescriptionTenant,
-				Type:        proto.ColumnType_STRING,
-				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
-				Transform:   transform.FromValue(),
-			},
-		},
+		}),
 	}
 }
 
@@ -208,7 +173,7 @@ func listFileStorageFileSystems(ctx context.Context, d *plugin.QueryData, _ *plu
 	for pagesLeft {
 		response, err := session.FileStorageClient.ListFileSystems(ctx, request)
 		if err != nil {
-			return nil, err
+			return nil, wrapServiceError(err, session, "ListFileSystems")
 		}
 
 		for _, fileSystems := range response.Items {
@@ -270,7 +235,7 @@ func getFileStorageFileSystem(ctx context.Context, d *plugin.QueryData, h *plugi
 
 	response, err := session.FileStorageClient.GetFileSystem(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetFileSystem")
 	}
 
 	return response.FileSystem, nil
@@ -278,40 +243,6 @@ func getFileStorageFileSystem(ctx context.Context, d *plugin.QueryData, h *plugi
 
 //// TRANSFORM FUNCTION
 
-// Priority order for tags
-// 1. System Tags
-// 2. Defined Tags
-// 3. Free-form tags
-func fileSystemTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
-
-	freeformTags := fileSystemFreeformTags(d.HydrateItem)
-
-	var tags map[string]interface{}
-
-	if freeformTags != nil {
-		tags = map[string]interface{}{}
-		for k, v := range freeformTags {
-			tags[k] = v
-		}
-	}
-
-	definedTags := fileSystemDefinedTags(d.HydrateItem)
-
-	if definedTags != nil {
-		if tags == nil {
-			tags = map[string]interface{}{}
-		}
-		for _, v := range definedTags {
-			for key, value := range v {
-				tags[key] = value
-			}
-
-		}
-	}
-
-	return tags, nil
-}
-
 func fileSystemFreeformTags(item interface{}) map[string]string {
 	switch item := item.(type) {
 	case filestorage.FileSystem:
@@ -19,9 +19,9 @@ func tableFileStorageMountTarget(_ context.Context) *plugin.Table {
 		Name:        "oci_file_storage_mount_target",
 		Description: "OCI File Storage Mount Target",
 		Get: &plugin.GetConfig{
-			KeyColumns:        plugin.SingleColumn("id"),
-			ShouldIgnoreError: isNotFoundError([]string{"400"}),
-			Hydrate:           getFileStorageMountTarget,
+			KeyColumns:   plugin.SingleColumn("id"),
+			IgnoreConfig: &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: isNotFoundError([]string{"400"})},
+			Hydrate:      getFileStorageMountTarget,
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listFileStorageMountTargets,
@@ -53,7 +53,7 @@ func tableFileStorageMountTarget(_ context.Context) *plugin.Table {
 			},
 		},
 		GetMatrixItemFunc: BuildCompartementZonalList,
-		Columns: []*plugin.Column{
+		Columns: CommonColumns([]*plugin.Column{
 			{
 				Name:        "display_name",
 				Description: "A user-friendly name of the Mount Target.",
@@ -125,20 +125,6 @@ func tableFileStorageMountTarget(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 			},
 
-			//  Steampipe standard columns
-			{
-				Name:        "tags",
-				Description: ColumnDescriptionTags,
-				Type:        proto.ColumnType_JSON,
-				Transform:   transform.From(mountTargetTags),
-			},
-			{
-				Name:        "title",
-				Description: ColumnDescriptionTitle,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("DisplayName"),
-			},
-
 			// OCI standard columns
 			{
 				Name:        "region",
@@ -146,20 +132,7 @@ func tableFileStorageMountTarget(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("SubnetId").Transform(ociRegionName),
 			},
-			{
-				Name:        "compartment_id",
-				Description: ColumnDescriptionCompartment,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("CompartmentId"),
-			},
-			{
-				Name:        "tenant_id",
-				Description: ColumnDescriptionTenant,
-				Type:        proto.ColumnType_STRING,
-				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
-				Transform:   transform.FromValue(),
-			},
-		},
+		}),
 	}
 }
 
@@ -211,7 +184,7 @@ func listFileStorageMountTargets(ctx context.Context, d *plugin.QueryData, h *pl
 		response, err := session.FileStorageClient.ListMountTargets(ctx, request)
 		if err != nil {
 			plugin.Logger(ctx).Trace("GetError", err)
-			return nil, err
+			return nil, wrapServiceError(err, session, "ListMountTargets")
 		}
 
 		for _, mountTarget := range response.Items {
@@ -273,7 +246,7 @@ func getFileStorageMountTarget(ctx context.Context, d *plugin.QueryData, h *plug
 
 	response, err := session.FileStorageClient.GetMountTarget(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetMountTarget")
 	}
 
 	return response.MountTarget, nil
@@ -281,46 +254,6 @@ func getFileStorageMountTarget(ctx context.Context, d *plugin.QueryData, h *plug
 
 //// TRANSFORM FUNCTION
 
-func mountTargetTags(ctx context.Context, d *transform.TransformData) (interface{}, error) {
-
-	var freeformTags map[string]string
-	var definedTags map[string]map[string]interface{}
-
-	switch d.HydrateItem.(type) {
-	case filestorage.MountTargetSummary:
-		mountTarget := d.HydrateItem.(filestorage.MountTargetSummary)
-		freeformTags = mountTarget.FreeformTags
-		definedTags = mountTarget.DefinedTags
-	case filestorage.MountTarget:
-		mountTarget := d.HydrateItem.(filestorage.MountTarget)
-		freeformTags = mountTarget.FreeformTags
-		definedTags = mountTarget.DefinedTags
-	}
-
-	var tags map[string]interface{}
-
-	if freeformTags != nil {
-		tags = map[string]interface{}{}
-		for k, v := range freeformTags {
-			tags[k] = v
-		}
-	}
-
-	if definedTags != nil {
-		if tags == nil {
-			tags = map[string]interface{}{}
-		}
-		for _, v := range definedTags {
-			for key, value := range v {
-				tags[key] = value
-			}
-
-		}
-	}
-
-	return tags, nil
-}
-
 // Build additional filters
 func buildFileStorageMountTargetFilters(equalQuals plugin.KeyColumnEqualsQualMap) filestorage.ListMountTargetsRequest {
 	request := filestorage.ListMountTargetsRequest{}
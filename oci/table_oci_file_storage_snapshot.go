@@ -19,9 +19,9 @@ func tableFileStorageSnapshot(_ context.Context) *plugin.Table {
 		Name:        "oci_file_storage_snapshot",
 		Description: "OCI File Storage Snapshot",
 		Get: &plugin.GetConfig{
-			KeyColumns:        plugin.SingleColumn("id"),
-			ShouldIgnoreError: isNotFoundError([]string{"400"}),
-			Hydrate:           getFileStorageSnapshot,
+			KeyColumns:   plugin.SingleColumn("id"),
+			IgnoreConfig: &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: isNotFoundError([]string{"400"})},
+			Hydrate:      getFileStorageSnapshot,
 		},
 		List: &plugin.ListConfig{
 			Hydrate:       listFileStorageSnapshots,
@@ -42,7 +42,7 @@ func tableFileStorageSnapshot(_ context.Context) *plugin.Table {
 			},
 		},
 		GetMatrixItemFunc: BuildCompartementZonalList,
-		Columns: []*plugin.Column{
+		Columns: CommonColumns([]*plugin.Column{
 			{
 				Name:        "name",
 				Description: "Name of the snapshot.",
@@ -101,40 +101,13 @@ func tableFileStorageSnapshot(_ context.Context) *plugin.Table {
 			},
 
 			//  Steampipe standard columns
-			{
-				Name:        "tags",
-				Description: ColumnDescriptionTags,
-				Type:        proto.ColumnType_JSON,
-				Transform:   transform.From(snapshotTags),
-			},
 			{
 				Name:        "title",
 				Description: ColumnDescriptionTitle,
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("Name"),
 			},
-
-			// Standard OCI columns
-			{
-				Name:        "region",
-				Description: ColumnDescriptionRegion,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("Id").Transform(ociRegionName),
-			},
-			{
-				Name:        "compartment_id",
-				Description: ColumnDescriptionCompartment,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("CompartmentId"),
-			},
-			{
-				Name:        "tenant_id",
-				Description: ColumnDescriptionTenant,
-				Type:        proto.ColumnType_STRING,
-				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
-				Transform:   transform.FromValue(),
-			},
-		},
+		}),
 	}
 }
 
@@ -198,7 +171,7 @@ func listFileStorageSnapshots(ctx context.Context, d *plugin.QueryData, h *plugi
 		response, err := session.FileStorageClient.ListSnapshots(ctx, request)
 		if err != nil {
 			plugin.Logger(ctx).Trace("GetError", err)
-			return nil, err
+			return nil, wrapServiceError(err, session, "ListSnapshots")
 		}
 
 		for _, snapshots := range response.Items {
@@ -256,7 +229,7 @@ func getFileStorageSnapshot(ctx context.Context, d *plugin.QueryData, h *plugin.
 
 	response, err := session.FileStorageClient.GetSnapshot(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetSnapshot")
 	}
 
 	snapshot := filestorage.SnapshotSummary{
@@ -275,39 +248,3 @@ func getFileStorageSnapshot(ctx context.Context, d *plugin.QueryData, h *plugin.
 
 	return rowData, nil
 }
-
-//// TRANSFORM FUNCTION
-
-// Priority order for tags
-// 1. System Tags
-// 2. Defined Tags
-// 3. Free-form tags
-func snapshotTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
-
-	var tags map[string]interface{}
-
-	freeformTags := d.HydrateItem.(snapshotInfo).FreeformTags
-
-	if freeformTags != nil {
-		tags = map[string]interface{}{}
-		for k, v := range freeformTags {
-			tags[k] = v
-		}
-	}
-
-	definedTags := d.HydrateItem.(snapshotInfo).DefinedTags
-
-	if definedTags != nil {
-		if tags == nil {
-			tags = map[string]interface{}{}
-		}
-		for _, v := range definedTags {
-			for key, value := range v {
-				tags[key] = value
-			}
-
-		}
-	}
-
-	return tags, nil
-}
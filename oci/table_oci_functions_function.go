@@ -26,6 +26,10 @@ func tableFunctionsFunction(_ context.Context) *plugin.Table {
 			ParentHydrate: listFunctionsApplications,
 			Hydrate:       listFunctions,
 			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "compartment_id",
+					Require: plugin.Optional,
+				},
 				{
 					Name:    "id",
 					Require: plugin.Optional,
@@ -37,7 +41,7 @@ func tableFunctionsFunction(_ context.Context) *plugin.Table {
 			},
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
-		Columns: []*plugin.Column{
+		Columns: CommonColumns([]*plugin.Column{
 			{
 				Name:        "display_name",
 				Description: "The display name of the function.",
@@ -123,42 +127,7 @@ func tableFunctionsFunction(_ context.Context) *plugin.Table {
 				Description: ColumnDescriptionFreefromTags,
 				Type:        proto.ColumnType_JSON,
 			},
-
-			// Standard Steampipe columns
-			{
-				Name:        "tags",
-				Description: ColumnDescriptionTags,
-				Type:        proto.ColumnType_JSON,
-				Transform:   transform.From(functionTags),
-			},
-			{
-				Name:        "title",
-				Description: ColumnDescriptionTitle,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("DisplayName"),
-			},
-
-			// Standard OCI columns
-			{
-				Name:        "region",
-				Description: ColumnDescriptionRegion,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("Id").Transform(ociRegionName),
-			},
-			{
-				Name:        "compartment_id",
-				Description: ColumnDescriptionCompartment,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("CompartmentId"),
-			},
-			{
-				Name:        "tenant_id",
-				Description: ColumnDescriptionTenant,
-				Type:        proto.ColumnType_STRING,
-				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
-				Transform:   transform.FromValue(),
-			},
-		},
+		}),
 	}
 }
 
@@ -214,7 +183,7 @@ func listFunctions(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateDa
 		response, err := session.FunctionsManagementClient.ListFunctions(ctx, request)
 		if err != nil {
 			logger.Error("listFunctions", "error_ListFunctions", err)
-			return nil, err
+			return nil, wrapServiceError(err, session, "ListFunctions")
 		}
 
 		for _, item := range response.Items {
@@ -277,7 +246,7 @@ func getFunction(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData
 	response, err := session.FunctionsManagementClient.GetFunction(ctx, request)
 	if err != nil {
 		logger.Error("getFunction", "error_GetFunction", err)
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetFunction")
 	}
 
 	return response.Function, nil
@@ -285,40 +254,6 @@ func getFunction(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData
 
 //// TRANSFORM FUNCTION
 
-// Priority order for tags
-// 1. System Tags
-// 2. Defined Tags
-// 3. Free-form tags
-func functionTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
-
-	freeformTags := functionFreeformTags(d.HydrateItem)
-
-	var tags map[string]interface{}
-
-	if freeformTags != nil {
-		tags = map[string]interface{}{}
-		for k, v := range freeformTags {
-			tags[k] = v
-		}
-	}
-
-	definedTags := functionDefinedTags(d.HydrateItem)
-
-	if definedTags != nil {
-		if tags == nil {
-			tags = map[string]interface{}{}
-		}
-		for _, v := range definedTags {
-			for key, value := range v {
-				tags[key] = value
-			}
-
-		}
-	}
-
-	return tags, nil
-}
-
 func functionFreeformTags(item interface{}) map[string]string {
 	switch item := item.(type) {
 	case functions.Function:
@@ -28,7 +28,8 @@ func tableIdentityApiKey(_ context.Context) *plugin.Table {
 				},
 			},
 		},
-		Columns: []*plugin.Column{
+		GetMatrixItemFunc: BuildTenancyList,
+		Columns: TenancyColumns([]*plugin.Column{
 			{
 				Name:        "key_id",
 				Description: "An Oracle-assigned identifier for the key.",
@@ -80,16 +81,7 @@ func tableIdentityApiKey(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("KeyId"),
 			},
-
-			// Standard OCI columns
-			{
-				Name:        "tenant_id",
-				Description: ColumnDescriptionTenant,
-				Type:        proto.ColumnType_STRING,
-				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
-				Transform:   transform.FromValue(),
-			},
-		},
+		}),
 	}
 }
 
@@ -131,7 +123,7 @@ func listIdentityApiKeys(ctx context.Context, d *plugin.QueryData, h *plugin.Hyd
 				return nil, nil
 			}
 		}
-		return nil, err
+		return nil, wrapServiceError(err, session, "ListApiKeys")
 	}
 
 	for _, apiKey := range item.Items {
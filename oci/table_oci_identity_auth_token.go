@@ -26,7 +26,8 @@ func tableIdentityAuthToken(_ context.Context) *plugin.Table {
 				},
 			},
 		},
-		Columns: []*plugin.Column{
+		GetMatrixItemFunc: BuildTenancyList,
+		Columns: TenancyColumns([]*plugin.Column{
 			{
 				Name:        "id",
 				Description: "The OCID of the auth token.",
@@ -86,16 +87,7 @@ func tableIdentityAuthToken(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("Id"),
 			},
-
-			// Standard OCI columns
-			{
-				Name:        "tenant_id",
-				Description: ColumnDescriptionTenant,
-				Type:        proto.ColumnType_STRING,
-				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
-				Transform:   transform.FromValue(),
-			},
-		},
+		}),
 	}
 }
 
@@ -132,7 +124,7 @@ func listIdentityAuthTokens(ctx context.Context, d *plugin.QueryData, h *plugin.
 	// List user auth tokens
 	item, err := session.IdentityClient.ListAuthTokens(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "ListAuthTokens")
 	}
 
 	for _, authToken := range item.Items {
@@ -19,7 +19,8 @@ func tableIdentityAuthenticationPolicy(_ context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: listAuthenticationPolicy,
 		},
-		Columns: []*plugin.Column{
+		GetMatrixItemFunc: BuildTenancyList,
+		Columns: CommonColumns([]*plugin.Column{
 			// Password Policy
 			{
 				Name:        "is_lowercase_characters_required",
@@ -73,7 +74,7 @@ func tableIdentityAuthenticationPolicy(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("CompartmentId"),
 			},
-		},
+		}),
 	}
 }
 
@@ -96,7 +97,7 @@ func listAuthenticationPolicy(ctx context.Context, d *plugin.QueryData, _ *plugi
 
 	response, err := session.IdentityClient.GetAuthenticationPolicy(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetAuthenticationPolicy")
 	}
 
 	d.StreamListItem(ctx, response.AuthenticationPolicy)
@@ -20,7 +20,8 @@ func tableIdentityAvailabilityDomain(_ context.Context) *plugin.Table {
 			ParentHydrate: listRegions,
 			Hydrate:       lisAvailabilityDomains,
 		},
-		Columns: []*plugin.Column{
+		GetMatrixItemFunc: BuildTenancyList,
+		Columns: TenancyColumns([]*plugin.Column{
 			{
 				Name:        "name",
 				Description: "The name of the Availability Domain.",
@@ -53,7 +54,7 @@ func tableIdentityAvailabilityDomain(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("CompartmentId"),
 			},
-		},
+		}),
 	}
 }
 
@@ -91,7 +92,7 @@ func lisAvailabilityDomains(ctx context.Context, d *plugin.QueryData, h *plugin.
 
 	response, err := session.IdentityClient.ListAvailabilityDomains(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "ListAvailabilityDomains")
 	}
 
 	for _, availabilityDomain := range response.Items {
@@ -34,7 +34,8 @@ func tableIdentityCompartment(_ context.Context) *plugin.Table {
 				},
 			},
 		},
-		Columns: []*plugin.Column{
+		GetMatrixItemFunc: BuildTenancyList,
+		Columns: CommonColumns([]*plugin.Column{
 			{
 				Name:        "name",
 				Description: "The name assigned to the compartment during creation",
@@ -88,12 +89,6 @@ func tableIdentityCompartment(_ context.Context) *plugin.Table {
 			},
 
 			// Standard Steampipe columns
-			{
-				Name:        "tags",
-				Description: ColumnDescriptionTags,
-				Type:        proto.ColumnType_JSON,
-				Transform:   transform.From(compartmentTags),
-			},
 			{
 				Name:        "title",
 				Description: ColumnDescriptionTitle,
@@ -108,14 +103,7 @@ func tableIdentityCompartment(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromCamel(),
 			},
-			{
-				Name:        "tenant_id",
-				Description: ColumnDescriptionTenant,
-				Type:        proto.ColumnType_STRING,
-				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
-				Transform:   transform.FromValue(),
-			},
-		},
+		}),
 	}
 }
 
@@ -139,7 +127,7 @@ func listCompartments(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydrat
 
 	responseRoot, err := session.IdentityClient.GetCompartment(ctx, rootRequest)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetCompartment")
 	}
 
 	if responseRoot.CompartmentId != nil {
@@ -178,7 +166,7 @@ func listCompartments(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydrat
 	for pagesLeft {
 		response, err := session.IdentityClient.ListCompartments(ctx, request)
 		if err != nil {
-			return nil, err
+			return nil, wrapServiceError(err, session, "ListCompartments")
 		}
 
 		for _, compartment := range response.Items {
@@ -221,36 +209,8 @@ func getCompartment(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateD
 
 	response, err := session.IdentityClient.GetCompartment(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetCompartment")
 	}
 
 	return response.Compartment, nil
 }
-
-//// TRANSFORM FUNCTION
-
-func compartmentTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
-	compartment := d.HydrateItem.(identity.Compartment)
-
-	var tags map[string]interface{}
-
-	if compartment.FreeformTags != nil {
-		tags = map[string]interface{}{}
-		for k, v := range compartment.FreeformTags {
-			tags[k] = v
-		}
-	}
-
-	if compartment.DefinedTags != nil {
-		if tags == nil {
-			tags = map[string]interface{}{}
-		}
-		for _, v := range compartment.DefinedTags {
-			for key, value := range v {
-				tags[key] = value
-			}
-
-		}
-	}
-	return tags, nil
-}
@@ -20,7 +20,8 @@ func tableIdentityCustomerSecretKey(_ context.Context) *plugin.Table {
 			ParentHydrate: listUsers,
 			Hydrate:       listIdentityCustomerSecretKeys,
 		},
-		Columns: []*plugin.Column{
+		GetMatrixItemFunc: BuildTenancyList,
+		Columns: TenancyColumns([]*plugin.Column{
 			{
 				Name:        "id",
 				Description: "The OCID of the secret key.",
@@ -65,24 +66,7 @@ func tableIdentityCustomerSecretKey(_ context.Context) *plugin.Table {
 				Description: "The detailed status of INACTIVE lifecycleState.",
 				Type:        proto.ColumnType_INT,
 			},
-
-			// Standard Steampipe columns
-			{
-				Name:        "title",
-				Description: ColumnDescriptionTitle,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("DisplayName"),
-			},
-
-			// Standard OCI columns
-			{
-				Name:        "tenant_id",
-				Description: ColumnDescriptionTenant,
-				Type:        proto.ColumnType_STRING,
-				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
-				Transform:   transform.FromValue(),
-			},
-		},
+		}),
 	}
 }
 
@@ -114,7 +98,7 @@ func listIdentityCustomerSecretKeys(ctx context.Context, d *plugin.QueryData, h
 	// List user's customer secret key
 	item, err := session.IdentityClient.ListCustomerSecretKeys(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "ListCustomerSecretKeys")
 	}
 
 	for _, secretKey := range item.Items {
@@ -34,7 +34,8 @@ func tableIdentityDynamicGroup(_ context.Context) *plugin.Table {
 				},
 			},
 		},
-		Columns: []*plugin.Column{
+		GetMatrixItemFunc: BuildTenancyList,
+		Columns: CommonColumns([]*plugin.Column{
 			{
 				Name:        "name",
 				Description: "The name you assign to the group during creation.",
@@ -87,12 +88,6 @@ func tableIdentityDynamicGroup(_ context.Context) *plugin.Table {
 			},
 
 			// Standard Steampipe columns
-			{
-				Name:        "tags",
-				Description: ColumnDescriptionTags,
-				Type:        proto.ColumnType_JSON,
-				Transform:   transform.From(dynamicGroupTags),
-			},
 			{
 				Name:        "title",
 				Description: ColumnDescriptionTitle,
@@ -107,7 +102,7 @@ func tableIdentityDynamicGroup(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("CompartmentId"),
 			},
-		},
+		}),
 	}
 }
 
@@ -153,7 +148,7 @@ func listIdentityDynamicGroups(ctx context.Context, d *plugin.QueryData, _ *plug
 	for pagesLeft {
 		response, err := session.IdentityClient.ListDynamicGroups(ctx, request)
 		if err != nil {
-			return nil, err
+			return nil, wrapServiceError(err, session, "ListDynamicGroups")
 		}
 
 		for _, dynamicGroup := range response.Items {
@@ -201,37 +196,8 @@ func getIdentityDynamicGroup(ctx context.Context, d *plugin.QueryData, _ *plugin
 
 	response, err := session.IdentityClient.GetDynamicGroup(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetDynamicGroup")
 	}
 
 	return response.DynamicGroup, nil
 }
-
-//// TRANSFORM FUNCTION
-
-func dynamicGroupTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
-	dynanicGroup := d.HydrateItem.(identity.DynamicGroup)
-
-	var tags map[string]interface{}
-
-	if dynanicGroup.FreeformTags != nil {
-		tags = map[string]interface{}{}
-		for k, v := range dynanicGroup.FreeformTags {
-			tags[k] = v
-		}
-	}
-
-	if dynanicGroup.DefinedTags != nil {
-		if tags == nil {
-			tags = map[string]interface{}{}
-		}
-		for _, v := range dynanicGroup.DefinedTags {
-			for key, value := range v {
-				tags[key] = value
-			}
-
-		}
-	}
-
-	return tags, nil
-}
@@ -34,7 +34,8 @@ func tableIdentityGroup(_ context.Context) *plugin.Table {
 				},
 			},
 		},
-		Columns: []*plugin.Column{
+		GetMatrixItemFunc: BuildTenancyList,
+		Columns: CommonColumns([]*plugin.Column{
 			{
 				Name:        "name",
 				Description: "The name assign to the group during creation.",
@@ -79,12 +80,6 @@ func tableIdentityGroup(_ context.Context) *plugin.Table {
 			},
 
 			// Standard Steampipe columns
-			{
-				Name:        "tags",
-				Description: ColumnDescriptionTags,
-				Type:        proto.ColumnType_JSON,
-				Transform:   transform.From(groupTags),
-			},
 			{
 				Name:        "title",
 				Description: ColumnDescriptionTitle,
@@ -99,7 +94,7 @@ func tableIdentityGroup(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("CompartmentId"),
 			},
-		},
+		}),
 	}
 }
 
@@ -145,7 +140,7 @@ func listGroup(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData)
 	for pagesLeft {
 		response, err := session.IdentityClient.ListGroups(ctx, request)
 		if err != nil {
-			return nil, err
+			return nil, wrapServiceError(err, session, "ListGroups")
 		}
 
 		for _, group := range response.Items {
@@ -188,37 +183,8 @@ func getGroup(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (
 
 	response, err := session.IdentityClient.GetGroup(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetGroup")
 	}
 
 	return response.Group, nil
 }
-
-//// TRANSFORM FUNCTION
-
-func groupTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
-	group := d.HydrateItem.(identity.Group)
-
-	var tags map[string]interface{}
-
-	if group.FreeformTags != nil {
-		tags = map[string]interface{}{}
-		for k, v := range group.FreeformTags {
-			tags[k] = v
-		}
-	}
-
-	if group.DefinedTags != nil {
-		if tags == nil {
-			tags = map[string]interface{}{}
-		}
-		for _, v := range group.DefinedTags {
-			for key, value := range v {
-				tags[key] = value
-			}
-
-		}
-	}
-
-	return tags, nil
-}
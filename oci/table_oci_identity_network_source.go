@@ -34,7 +34,8 @@ func tableIdentityNetworkSource(_ context.Context) *plugin.Table {
 				},
 			},
 		},
-		Columns: []*plugin.Column{
+		GetMatrixItemFunc: BuildTenancyList,
+		Columns: CommonColumns([]*plugin.Column{
 			{
 				Name:        "name",
 				Description: "The name you assign to the network source during creation.",
@@ -105,7 +106,7 @@ func tableIdentityNetworkSource(_ context.Context) *plugin.Table {
 				Description: ColumnDescriptionTags,
 				Type:        proto.ColumnType_JSON,
 				Hydrate:     getIdentityNetworkSource,
-				Transform:   transform.From(networkSourceTags),
+				Transform:   transform.From(commonTags),
 			},
 			{
 				Name:        "title",
@@ -121,7 +122,7 @@ func tableIdentityNetworkSource(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("CompartmentId"),
 			},
-		},
+		}),
 	}
 }
 
@@ -167,7 +168,7 @@ func listIdentityNetworkSources(ctx context.Context, d *plugin.QueryData, _ *plu
 	for pagesLeft {
 		response, err := session.IdentityClient.ListNetworkSources(ctx, request)
 		if err != nil {
-			return nil, err
+			return nil, wrapServiceError(err, session, "ListNetworkSources")
 		}
 
 		for _, networkSources := range response.Items {
@@ -220,37 +221,8 @@ func getIdentityNetworkSource(ctx context.Context, d *plugin.QueryData, h *plugi
 
 	response, err := session.IdentityClient.GetNetworkSource(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetNetworkSource")
 	}
 
 	return response.NetworkSources, nil
 }
-
-//// TRANSFORM FUNCTION
-
-func networkSourceTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
-	networkSource := d.HydrateItem.(identity.NetworkSources)
-
-	var tags map[string]interface{}
-
-	if networkSource.FreeformTags != nil {
-		tags = map[string]interface{}{}
-		for k, v := range networkSource.FreeformTags {
-			tags[k] = v
-		}
-	}
-
-	if networkSource.DefinedTags != nil {
-		if tags == nil {
-			tags = map[string]interface{}{}
-		}
-		for _, v := range networkSource.DefinedTags {
-			for key, value := range v {
-				tags[key] = value
-			}
-
-		}
-	}
-
-	return tags, nil
-}
@@ -34,7 +34,8 @@ func tableIdentityPolicy(_ context.Context) *plugin.Table {
 				},
 			},
 		},
-		Columns: []*plugin.Column{
+		GetMatrixItemFunc: BuildTenancyList,
+		Columns: CommonColumns([]*plugin.Column{
 			// top columns
 			{
 				Name:        "name",
@@ -95,12 +96,6 @@ func tableIdentityPolicy(_ context.Context) *plugin.Table {
 			},
 
 			// Standard Steampipe columns
-			{
-				Name:        "tags",
-				Description: ColumnDescriptionTags,
-				Type:        proto.ColumnType_JSON,
-				Transform:   transform.From(policyTags),
-			},
 			{
 				Name:        "title",
 				Description: ColumnDescriptionTitle,
@@ -115,7 +110,7 @@ func tableIdentityPolicy(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("CompartmentId"),
 			},
-		},
+		}),
 	}
 }
 
@@ -161,7 +156,7 @@ func listPolicy(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData)
 	for pagesLeft {
 		response, err := session.IdentityClient.ListPolicies(ctx, request)
 		if err != nil {
-			return nil, err
+			return nil, wrapServiceError(err, session, "ListPolicies")
 		}
 
 		for _, user := range response.Items {
@@ -204,37 +199,8 @@ func getPolicy(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData)
 
 	response, err := session.IdentityClient.GetPolicy(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetPolicy")
 	}
 
 	return response.Policy, nil
 }
-
-//// TRANSFORM FUNCTION
-
-func policyTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
-	policy := d.HydrateItem.(identity.Policy)
-
-	var tags map[string]interface{}
-
-	if policy.FreeformTags != nil {
-		tags = map[string]interface{}{}
-		for k, v := range policy.FreeformTags {
-			tags[k] = v
-		}
-	}
-
-	if policy.DefinedTags != nil {
-		if tags == nil {
-			tags = map[string]interface{}{}
-		}
-		for _, v := range policy.DefinedTags {
-			for key, value := range v {
-				tags[key] = value
-			}
-
-		}
-	}
-
-	return tags, nil
-}
@@ -36,7 +36,7 @@ func tableIdentityTagDefault(_ context.Context) *plugin.Table {
 			},
 		},
 		GetMatrixItemFunc: BuildCompartmentList,
-		Columns: []*plugin.Column{
+		Columns: CommonColumns([]*plugin.Column{
 			{
 				Name:        "id",
 				Description: "The OCID of the tag default.",
@@ -92,22 +92,7 @@ func tableIdentityTagDefault(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("Id"),
 			},
-
-			// Standard OCI columns
-			{
-				Name:        "compartment_id",
-				Description: ColumnDescriptionCompartment,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("CompartmentId"),
-			},
-			{
-				Name:        "tenant_id",
-				Description: ColumnDescriptionTenant,
-				Type:        proto.ColumnType_STRING,
-				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
-				Transform:   transform.FromValue(),
-			},
-		},
+		}),
 	}
 }
 
@@ -156,7 +141,7 @@ func listIdentityTagDefaults(ctx context.Context, d *plugin.QueryData, _ *plugin
 	for pagesLeft {
 		response, err := session.IdentityClient.ListTagDefaults(ctx, request)
 		if err != nil {
-			return nil, err
+			return nil, wrapServiceError(err, session, "ListTagDefaults")
 		}
 		for _, tagDefault := range response.Items {
 			d.StreamListItem(ctx, tagDefault)
@@ -210,7 +195,7 @@ func getIdentityTagDefault(ctx context.Context, d *plugin.QueryData, h *plugin.H
 
 	response, err := session.IdentityClient.GetTagDefault(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetTagDefault")
 	}
 
 	return response.TagDefault, nil
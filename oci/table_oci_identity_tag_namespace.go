@@ -36,7 +36,7 @@ func tableIdentityTagNamespace(_ context.Context) *plugin.Table {
 			},
 		},
 		GetMatrixItemFunc: BuildCompartmentList,
-		Columns: []*plugin.Column{
+		Columns: CommonColumns([]*plugin.Column{
 			{
 				Name:        "name",
 				Description: "The name of the tag namespace. It must be unique across all tag namespaces in the tenancy and cannot be changed.",
@@ -91,28 +91,7 @@ func tableIdentityTagNamespace(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("Name"),
 			},
-			{
-				Name:        "tags",
-				Description: ColumnDescriptionTags,
-				Type:        proto.ColumnType_JSON,
-				Transform:   transform.From(identityTagNamespaceTags),
-			},
-
-			// Standard OCI columns
-			{
-				Name:        "compartment_id",
-				Description: ColumnDescriptionCompartment,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("CompartmentId"),
-			},
-			{
-				Name:        "tenant_id",
-				Description: ColumnDescriptionTenant,
-				Type:        proto.ColumnType_STRING,
-				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
-				Transform:   transform.FromValue(),
-			},
-		},
+		}),
 	}
 }
 
@@ -161,7 +140,7 @@ func listIdentityTagNamespaces(ctx context.Context, d *plugin.QueryData, _ *plug
 	for pagesLeft {
 		response, err := session.IdentityClient.ListTagNamespaces(ctx, request)
 		if err != nil {
-			return nil, err
+			return nil, wrapServiceError(err, session, "ListTagNamespaces")
 		}
 		for _, tagNamespace := range response.Items {
 			d.StreamListItem(ctx, tagNamespace)
@@ -215,53 +194,8 @@ func getIdentityTagNamespace(ctx context.Context, d *plugin.QueryData, h *plugin
 
 	response, err := session.IdentityClient.GetTagNamespace(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetTagNamespace")
 	}
 
 	return response.TagNamespace, nil
 }
-
-//// TRANSFORM FUNCTION
-
-// Priority order for tags
-// 2. Defined Tags
-// 3. Free-form tags
-func identityTagNamespaceTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
-
-	var freeformTags map[string]string
-	var definedTags map[string]map[string]interface{}
-
-	switch d.HydrateItem.(type) {
-	case identity.TagNamespaceSummary:
-		tagNamespace := d.HydrateItem.(identity.TagNamespaceSummary)
-		freeformTags = tagNamespace.FreeformTags
-		definedTags = tagNamespace.DefinedTags
-	case identity.TagNamespace:
-		tagNamespace := d.HydrateItem.(identity.TagNamespace)
-		freeformTags = tagNamespace.FreeformTags
-		definedTags = tagNamespace.DefinedTags
-	}
-
-	var tags map[string]interface{}
-
-	if freeformTags != nil {
-		tags = map[string]interface{}{}
-		for k, v := range freeformTags {
-			tags[k] = v
-		}
-	}
-
-	if definedTags != nil {
-		if tags == nil {
-			tags = map[string]interface{}{}
-		}
-		for _, v := range definedTags {
-			for key, value := range v {
-				tags[key] = value
-			}
-
-		}
-	}
-
-	return tags, nil
-}
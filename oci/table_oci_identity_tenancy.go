@@ -22,11 +22,12 @@ func tableIdentityTenancy(_ context.Context) *plugin.Table {
 		},
 		HydrateConfig: []plugin.HydrateConfig{
 			{
-				Func:              getRetentionPeriod,
-				ShouldIgnoreError: isNotFoundError([]string{"404"}),
+				Func:         getRetentionPeriod,
+				IgnoreConfig: &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: isNotFoundError([]string{"404"})},
 			},
 		},
-		Columns: []*plugin.Column{
+		GetMatrixItemFunc: BuildTenancyList,
+		Columns: TenancyColumns([]*plugin.Column{
 			{
 				Name:        "name",
 				Description: "The name of the tenancy.",
@@ -74,12 +75,6 @@ func tableIdentityTenancy(_ context.Context) *plugin.Table {
 			},
 
 			// Standard Steampipe columns
-			{
-				Name:        "tags",
-				Description: ColumnDescriptionTags,
-				Type:        proto.ColumnType_JSON,
-				Transform:   transform.From(tenantTags),
-			},
 			{
 				Name:        "title",
 				Description: ColumnDescriptionTitle,
@@ -94,14 +89,7 @@ func tableIdentityTenancy(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("Id"),
 			},
-			{
-				Name:        "tenant_id",
-				Description: ColumnDescriptionTenant,
-				Type:        proto.ColumnType_STRING,
-				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
-				Transform:   transform.FromValue(),
-			},
-		},
+		}),
 	}
 }
 
@@ -124,7 +112,7 @@ func listIdentityTenancies(ctx context.Context, d *plugin.QueryData, _ *plugin.H
 
 	response, err := session.IdentityClient.GetTenancy(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetTenancy")
 	}
 	d.StreamListItem(ctx, response.Tenancy)
 
@@ -158,37 +146,8 @@ func getRetentionPeriod(ctx context.Context, d *plugin.QueryData, h *plugin.Hydr
 
 	response, err := session.AuditClient.GetConfiguration(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetConfiguration")
 	}
 
 	return response.Configuration, nil
 }
-
-//// TRANSFORM FUNCTION
-
-func tenantTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
-	tenancy := d.HydrateItem.(identity.Tenancy)
-
-	var tags map[string]interface{}
-
-	if tenancy.FreeformTags != nil {
-		tags = map[string]interface{}{}
-		for k, v := range tenancy.FreeformTags {
-			tags[k] = v
-		}
-	}
-
-	if tenancy.DefinedTags != nil {
-		if tags == nil {
-			tags = map[string]interface{}{}
-		}
-		for _, v := range tenancy.DefinedTags {
-			for key, value := range v {
-				tags[key] = value
-			}
-
-		}
-	}
-
-	return tags, nil
-}
@@ -43,7 +43,8 @@ func tableIdentityUser(_ context.Context) *plugin.Table {
 				},
 			},
 		},
-		Columns: []*plugin.Column{
+		GetMatrixItemFunc: BuildTenancyList,
+		Columns: CommonColumns([]*plugin.Column{
 			{
 				Name:        "name",
 				Description: "The user's login for the Console.",
@@ -165,12 +166,6 @@ func tableIdentityUser(_ context.Context) *plugin.Table {
 			},
 
 			// Standard Steampipe columns
-			{
-				Name:        "tags",
-				Description: ColumnDescriptionTags,
-				Type:        proto.ColumnType_JSON,
-				Transform:   transform.From(userTags),
-			},
 			{
 				Name:        "title",
 				Description: ColumnDescriptionTitle,
@@ -185,7 +180,7 @@ func tableIdentityUser(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("CompartmentId"),
 			},
-		},
+		}),
 	}
 }
 
@@ -219,7 +214,7 @@ func listUsers(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData)
 	for pagesLeft {
 		response, err := session.IdentityClient.ListUsers(ctx, request)
 		if err != nil {
-			return nil, err
+			return nil, wrapServiceError(err, session, "ListUsers")
 		}
 
 		for _, user := range response.Items {
@@ -262,7 +257,7 @@ func getUser(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (i
 
 	response, err := session.IdentityClient.GetUser(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetUser")
 	}
 
 	return response.User, nil
@@ -291,7 +286,7 @@ func getUserGroups(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateDa
 	for pagesLeft {
 		response, err := session.IdentityClient.ListUserGroupMemberships(ctx, request)
 		if err != nil {
-			return nil, err
+			return nil, wrapServiceError(err, session, "ListUserGroupMemberships")
 		}
 
 		userGroups = append(userGroups, response.Items...)
@@ -307,33 +302,6 @@ func getUserGroups(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateDa
 
 //// TRANSFORM FUNCTION
 
-func userTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
-	user := d.HydrateItem.(identity.User)
-
-	var tags map[string]interface{}
-
-	if user.FreeformTags != nil {
-		tags = map[string]interface{}{}
-		for k, v := range user.FreeformTags {
-			tags[k] = v
-		}
-	}
-
-	if user.DefinedTags != nil {
-		if tags == nil {
-			tags = map[string]interface{}{}
-		}
-		for _, v := range user.DefinedTags {
-			for key, value := range v {
-				tags[key] = value
-			}
-
-		}
-	}
-
-	return tags, nil
-}
-
 func userType(_ context.Context, d *transform.TransformData) (interface{}, error) {
 	user := d.HydrateItem.(identity.User)
 
@@ -25,6 +25,10 @@ func tableKmsKey(_ context.Context) *plugin.Table {
 			ParentHydrate: listKmsVaults,
 			Hydrate:       listKmsKeys,
 			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "compartment_id",
+					Require: plugin.Optional,
+				},
 				{
 					Name:    "algorithm",
 					Require: plugin.Optional,
@@ -44,7 +48,7 @@ func tableKmsKey(_ context.Context) *plugin.Table {
 			},
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
-		Columns: []*plugin.Column{
+		Columns: CommonColumns([]*plugin.Column{
 			{
 				Name:        "name",
 				Description: "A user-friendly name of the key. Does not have to be unique, and it's changeable.",
@@ -138,40 +142,13 @@ func tableKmsKey(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 			},
 
-			// Standard Steampipe columns
-			{
-				Name:        "tags",
-				Description: ColumnDescriptionTags,
-				Type:        proto.ColumnType_JSON,
-				Transform:   transform.From(keyTags),
-			},
-			{
-				Name:        "title",
-				Description: ColumnDescriptionTitle,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("DisplayName"),
-			},
-
 			// Standard OCI columns
-			{
-				Name:        "region",
-				Description: ColumnDescriptionRegion,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("Id").Transform(ociRegionName),
-			},
 			{
 				Name:        "compartment_id",
 				Description: ColumnDescriptionCompartment,
 				Type:        proto.ColumnType_STRING,
 			},
-			{
-				Name:        "tenant_id",
-				Description: ColumnDescriptionTenant,
-				Type:        proto.ColumnType_STRING,
-				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
-				Transform:   transform.FromValue(),
-			},
-		},
+		}),
 	}
 }
 
@@ -271,7 +248,7 @@ func getKmsKeyAsyncData(ctx context.Context, d *plugin.QueryData, request keyman
 	for pagesLeft {
 		response, err := session.KmsManagementClient.ListKeys(ctx, request)
 		if err != nil {
-			return err
+			return wrapServiceError(err, session, "ListKeys")
 		}
 
 		for _, key := range response.Items {
@@ -316,7 +293,7 @@ func getKmsKey(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData)
 
 	response, err := session.KmsManagementClient.GetKey(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetKey")
 	}
 
 	return response.Key, nil
@@ -324,35 +301,6 @@ func getKmsKey(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData)
 
 //// TRANSFORM FUNCTION
 
-func keyTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
-	freeformTags := d.HydrateItem.(KeyInfo).FreeformTags
-
-	var tags map[string]interface{}
-
-	if freeformTags != nil {
-		tags = map[string]interface{}{}
-		for k, v := range freeformTags {
-			tags[k] = v
-		}
-	}
-
-	definedTags := d.HydrateItem.(KeyInfo).DefinedTags
-
-	if definedTags != nil {
-		if tags == nil {
-			tags = map[string]interface{}{}
-		}
-		for _, v := range definedTags {
-			for key, value := range v {
-				tags[key] = value
-			}
-
-		}
-	}
-
-	return tags, nil
-}
-
 // Extract OCI region name from the resource id
 func ociRegionNameFromId(resourceId string) common.Region {
 	id := types.SafeString(resourceId)
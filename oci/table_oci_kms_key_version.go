@@ -21,8 +21,11 @@ func tableKmsKeyVersion(_ context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			KeyColumns: plugin.AllColumns([]string{"key_id", "management_endpoint", "region"}),
 			Hydrate:    listKmsKeyVersions,
+			// the key belongs to only one of the tenancies of the connection
+			IgnoreConfig: &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: isNotFoundError([]string{"404"})},
 		},
-		Columns: []*plugin.Column{
+		GetMatrixItemFunc: BuildTenancyList,
+		Columns: CommonColumns([]*plugin.Column{
 			{
 				Name:        "id",
 				Description: "The OCID of the key version.",
@@ -97,14 +100,7 @@ func tableKmsKeyVersion(_ context.Context) *plugin.Table {
 				Description: ColumnDescriptionCompartment,
 				Type:        proto.ColumnType_STRING,
 			},
-			{
-				Name:        "tenant_id",
-				Description: ColumnDescriptionTenant,
-				Type:        proto.ColumnType_STRING,
-				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
-				Transform:   transform.FromValue(),
-			},
-		},
+		}),
 	}
 }
 
@@ -154,7 +150,7 @@ func listKmsKeyVersions(ctx context.Context, d *plugin.QueryData, h *plugin.Hydr
 	for pagesLeft {
 		response, err := session.KmsManagementClient.ListKeyVersions(ctx, request)
 		if err != nil {
-			return nil, err
+			return nil, wrapServiceError(err, session, "ListKeyVersions")
 		}
 
 		for _, keyVersion := range response.Items {
@@ -200,7 +196,7 @@ func getKmsKeyVersion(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrat
 
 	response, err := session.KmsManagementClient.GetKeyVersion(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetKeyVersion")
 	}
 
 	return response.KeyVersion, nil
@@ -19,9 +19,9 @@ func tableKmsVault(_ context.Context) *plugin.Table {
 		Name:        "oci_kms_vault",
 		Description: "OCI KMS Vault",
 		Get: &plugin.GetConfig{
-			KeyColumns:        plugin.SingleColumn("id"),
-			Hydrate:           getKmsVault,
-			ShouldIgnoreError: isNotFoundError([]string{"400", "404"}),
+			KeyColumns:   plugin.SingleColumn("id"),
+			Hydrate:      getKmsVault,
+			IgnoreConfig: &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: isNotFoundError([]string{"400", "404"})},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listKmsVaults,
@@ -33,7 +33,7 @@ func tableKmsVault(_ context.Context) *plugin.Table {
 			},
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
-		Columns: []*plugin.Column{
+		Columns: CommonColumns([]*plugin.Column{
 			{
 				Name:        "display_name",
 				Description: "A user-friendly name. Does not have to be unique, and it's changeable.",
@@ -104,42 +104,7 @@ func tableKmsVault(_ context.Context) *plugin.Table {
 				Description: ColumnDescriptionFreefromTags,
 				Type:        proto.ColumnType_JSON,
 			},
-
-			// Standard Steampipe columns
-			{
-				Name:        "tags",
-				Description: ColumnDescriptionTags,
-				Type:        proto.ColumnType_JSON,
-				Transform:   transform.From(vaultTags),
-			},
-			{
-				Name:        "title",
-				Description: ColumnDescriptionTitle,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("DisplayName"),
-			},
-
-			// Standard OCI columns
-			{
-				Name:        "region",
-				Description: ColumnDescriptionRegion,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("Id").Transform(ociRegionName),
-			},
-			{
-				Name:        "compartment_id",
-				Description: ColumnDescriptionCompartment,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("CompartmentId"),
-			},
-			{
-				Name:        "tenant_id",
-				Description: ColumnDescriptionTenant,
-				Type:        proto.ColumnType_STRING,
-				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
-				Transform:   transform.FromValue(),
-			},
-		},
+		}),
 	}
 }
 
@@ -184,7 +149,7 @@ func listKmsVaults(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateDa
 	for pagesLeft {
 		response, err := session.KmsVaultClient.ListVaults(ctx, request)
 		if err != nil {
-			return nil, err
+			return nil, wrapServiceError(err, session, "ListVaults")
 		}
 
 		for _, vault := range response.Items {
@@ -247,7 +212,7 @@ func getKmsVault(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData
 
 	response, err := session.KmsVaultClient.GetVault(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetVault")
 	}
 
 	return response.Vault, nil
@@ -255,35 +220,6 @@ func getKmsVault(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData
 
 //// TRANSFORM FUNCTION
 
-func vaultTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
-	freeformTags := vaultFreeformTags(d.HydrateItem)
-
-	var tags map[string]interface{}
-
-	if freeformTags != nil {
-		tags = map[string]interface{}{}
-		for k, v := range freeformTags {
-			tags[k] = v
-		}
-	}
-
-	definedTags := vaultDefinedTags(d.HydrateItem)
-
-	if definedTags != nil {
-		if tags == nil {
-			tags = map[string]interface{}{}
-		}
-		for _, v := range definedTags {
-			for key, value := range v {
-				tags[key] = value
-			}
-
-		}
-	}
-
-	return tags, nil
-}
-
 func vaultFreeformTags(item interface{}) map[string]string {
 	switch item := item.(type) {
 	case keymanagement.Vault:
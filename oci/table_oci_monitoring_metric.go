@@ -0,0 +1,286 @@
+package oci
+
+import (
+	"context"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v44/common"
+	"github.com/oracle/oci-go-sdk/v44/monitoring"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+// time range of a metric query without a start_time qual
+const defaultMonitoringMetricWindow = 24 * time.Hour
+
+//// TABLE DEFINITION
+
+func tableMonitoringMetric(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "oci_monitoring_metric",
+		Description: "OCI Monitoring Metric",
+		List: &plugin.ListConfig{
+			Hydrate: listMonitoringMetrics,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "namespace",
+					Require: plugin.Required,
+				},
+				{
+					Name:    "query",
+					Require: plugin.Required,
+				},
+				{
+					Name:    "resolution",
+					Require: plugin.Optional,
+				},
+				{
+					Name:      "start_time",
+					Require:   plugin.Optional,
+					Operators: []string{"=", ">", ">=", "<", "<="},
+				},
+				{
+					Name:      "end_time",
+					Require:   plugin.Optional,
+					Operators: []string{"=", ">", ">=", "<", "<="},
+				},
+				{
+					Name:    "resource_group",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "compartment_id",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		GetMatrixItemFunc: BuildCompartementRegionList,
+		Columns: CompartmentColumns([]*plugin.Column{
+			{
+				Name:        "namespace",
+				Description: "The source service or application that emitted the metric, e.g. oci_computeagent.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "query",
+				Description: "The Monitoring Query Language (MQL) expression of the query, e.g. CpuUtilization[1m]{availabilityDomain=\"cumS:PHX-AD-1\"}.mean().",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "name",
+				Description: "The name of the metric.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resolution",
+				Description: "The time between calculated aggregation windows, e.g. 5m. Defaults to 1m.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "start_time",
+				Description: "The beginning of the time range of the query. Defaults to 24 hours before the end time.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "end_time",
+				Description: "The end of the time range of the query. Defaults to now.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "resource_group",
+				Description: "Resource group provided with the posted metric.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "dimensions",
+				Description: "Qualifiers of the metric stream of the data point, e.g. resourceId.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "metadata",
+				Description: "The references provided in a metric definition to indicate extra information about the metric, e.g. unit.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "timestamp",
+				Description: "The time stamp used for the data point.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "value",
+				Description: "Numeric value of the metric for the data point.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "unit",
+				Description: "The standard unit for the data point.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Metadata.unit"),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "compartment_id",
+				Description: ColumnDescriptionCompartment,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenant,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(tenancyCacheKey("getTenantId")),
+				Transform:   transform.FromValue(),
+			},
+		}),
+	}
+}
+
+type monitoringMetricDatapoint struct {
+	CompartmentId *string
+	Namespace     *string
+	Query         *string
+	Name          *string
+	Resolution    *string
+	StartTime     *time.Time
+	EndTime       *time.Time
+	ResourceGroup *string
+	Dimensions    map[string]string
+	Metadata      map[string]string
+	Timestamp     *time.Time
+	Value         *float64
+	Region        string
+}
+
+//// LIST FUNCTION
+
+func listMonitoringMetrics(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	region := plugin.GetMatrixItem(ctx)[matrixKeyRegion].(string)
+	compartment := plugin.GetMatrixItem(ctx)[matrixKeyCompartment].(string)
+	logger.Debug("listMonitoringMetrics", "Compartment", compartment, "OCI_REGION", region)
+
+	equalQuals := d.KeyColumnQuals
+
+	// Return nil, if given compartment_id doesn't match
+	if equalQuals["compartment_id"] != nil && compartment != equalQuals["compartment_id"].GetStringValue() {
+		return nil, nil
+	}
+
+	// Create Session
+	session, err := monitoringService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	endTime := getMonitoringMetricQualTime(d, "end_time", time.Now())
+	startTime := getMonitoringMetricQualTime(d, "start_time", endTime.Add(-defaultMonitoringMetricWindow))
+
+	details := monitoring.SummarizeMetricsDataDetails{
+		Namespace: types.String(equalQuals["namespace"].GetStringValue()),
+		Query:     types.String(equalQuals["query"].GetStringValue()),
+		StartTime: &common.SDKTime{Time: startTime},
+		EndTime:   &common.SDKTime{Time: endTime},
+	}
+	if equalQuals["resolution"] != nil {
+		details.Resolution = types.String(equalQuals["resolution"].GetStringValue())
+	}
+	if equalQuals["resource_group"] != nil {
+		details.ResourceGroup = types.String(equalQuals["resource_group"].GetStringValue())
+	}
+
+	request := monitoring.SummarizeMetricsDataRequest{
+		CompartmentId:               types.String(compartment),
+		SummarizeMetricsDataDetails: details,
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	response, err := session.MonitoringClient.SummarizeMetricsData(ctx, request)
+	if err != nil {
+		return nil, wrapServiceError(err, session, "SummarizeMetricsData")
+	}
+
+	for _, item := range response.Items {
+		// the response has the default resolution of queries without one
+		resolution := details.Resolution
+		if resolution == nil {
+			resolution = item.Resolution
+		}
+		for _, datapoint := range item.AggregatedDatapoints {
+			d.StreamListItem(ctx, monitoringMetricDatapoint{
+				CompartmentId: item.CompartmentId,
+				Namespace:     item.Namespace,
+				Query:         details.Query,
+				Name:          item.Name,
+				Resolution:    resolution,
+				StartTime:     &startTime,
+				EndTime:       &endTime,
+				ResourceGroup: item.ResourceGroup,
+				Dimensions:    item.Dimensions,
+				Metadata:      item.Metadata,
+				Timestamp:     &datapoint.Timestamp.Time,
+				Value:         datapoint.Value,
+				Region:        region,
+			})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HELPER FUNCTIONS
+
+// getMonitoringMetricQualTime returns the time of a start_time or end_time
+// column which satisfies the quals of the column. Every row of the query has
+// that time, so it is the default time moved into the range of the quals, e.g.
+// start_time > now() - interval '1 hour' starts the query just after that time.
+func getMonitoringMetricQualTime(d *plugin.QueryData, column string, defaultTime time.Time) time.Time {
+	if d.Quals[column] == nil {
+		return defaultTime
+	}
+
+	var lower, upper *time.Time
+	for _, q := range d.Quals[column].Quals {
+		timestamp := q.Value.GetTimestampValue().AsTime()
+		switch q.Operator {
+		case "=":
+			return timestamp
+		case ">":
+			timestamp = timestamp.Add(time.Millisecond)
+			fallthrough
+		case ">=":
+			if lower == nil || timestamp.After(*lower) {
+				lower = &timestamp
+			}
+		case "<":
+			timestamp = timestamp.Add(-time.Millisecond)
+			fallthrough
+		case "<=":
+			if upper == nil || timestamp.Before(*upper) {
+				upper = &timestamp
+			}
+		}
+	}
+
+	value := defaultTime
+	if lower != nil && value.Before(*lower) {
+		value = *lower
+	}
+	if upper != nil && value.After(*upper) {
+		value = *upper
+	}
+	return value
+}
@@ -0,0 +1,159 @@
+package oci
+
+import (
+	"context"
+
+	"github.com/oracle/oci-go-sdk/v44/common"
+	"github.com/oracle/oci-go-sdk/v44/monitoring"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableMonitoringMetricDefinition(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "oci_monitoring_metric_definition",
+		Description: "OCI Monitoring Metric Definition",
+		List: &plugin.ListConfig{
+			Hydrate: listMonitoringMetricDefinitions,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "compartment_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "namespace",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "name",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "resource_group",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		GetMatrixItemFunc: BuildCompartementRegionList,
+		Columns: CompartmentColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the metric, e.g. CpuUtilization.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "namespace",
+				Description: "The source service or application that emitted the metric, e.g. oci_computeagent.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_group",
+				Description: "Resource group provided with the posted metric.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "dimensions",
+				Description: "Qualifiers provided in the definition of the metric, e.g. resourceId.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromMatrixItem(matrixKeyRegion),
+			},
+			{
+				Name:        "compartment_id",
+				Description: ColumnDescriptionCompartment,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenant,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(tenancyCacheKey("getTenantId")),
+				Transform:   transform.FromValue(),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listMonitoringMetricDefinitions(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	region := plugin.GetMatrixItem(ctx)[matrixKeyRegion].(string)
+	compartment := plugin.GetMatrixItem(ctx)[matrixKeyCompartment].(string)
+	logger.Debug("listMonitoringMetricDefinitions", "Compartment", compartment, "OCI_REGION", region)
+
+	equalQuals := d.KeyColumnQuals
+
+	// Return nil, if given compartment_id doesn't match
+	if equalQuals["compartment_id"] != nil && compartment != equalQuals["compartment_id"].GetStringValue() {
+		return nil, nil
+	}
+
+	// Create Session
+	session, err := monitoringService(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	request := monitoring.ListMetricsRequest{
+		CompartmentId: types.String(compartment),
+		Limit:         types.Int(1000),
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	// Check for additional filters
+	if equalQuals["namespace"] != nil {
+		request.Namespace = types.String(equalQuals["namespace"].GetStringValue())
+	}
+
+	if equalQuals["name"] != nil {
+		request.Name = types.String(equalQuals["name"].GetStringValue())
+	}
+
+	if equalQuals["resource_group"] != nil {
+		request.ResourceGroup = types.String(equalQuals["resource_group"].GetStringValue())
+	}
+
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < int64(*request.Limit) {
+			request.Limit = types.Int(int(*limit))
+		}
+	}
+
+	pagesLeft := true
+	for pagesLeft {
+		response, err := session.MonitoringClient.ListMetrics(ctx, request)
+		if err != nil {
+			return nil, wrapServiceError(err, session, "ListMetrics")
+		}
+
+		for _, metric := range response.Items {
+			d.StreamListItem(ctx, metric)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		if response.OpcNextPage != nil {
+			request.Page = response.OpcNextPage
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, err
+}
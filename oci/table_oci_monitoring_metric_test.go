@@ -0,0 +1,37 @@
+package oci
+
+import (
+	"testing"
+	"time"
+)
+
+func TestGetMonitoringMetricQualTime(t *testing.T) {
+	t1 := time.Date(2022, 6, 1, 10, 0, 0, 0, time.UTC)
+	defaultTime := time.Date(2022, 6, 2, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		quals []timestampQual
+		want  time.Time
+	}{
+		{"no quals", nil, defaultTime},
+		{"=", []timestampQual{{"=", t1}}, t1},
+		{"> after the default", []timestampQual{{">", defaultTime.Add(time.Hour)}}, defaultTime.Add(time.Hour + time.Millisecond)},
+		{">= after the default", []timestampQual{{">=", defaultTime.Add(time.Hour)}}, defaultTime.Add(time.Hour)},
+		{"> before the default", []timestampQual{{">", t1}}, defaultTime},
+		{"< before the default", []timestampQual{{"<", t1}}, t1.Add(-time.Millisecond)},
+		{"<= before the default", []timestampQual{{"<=", t1}}, t1},
+		{"< after the default", []timestampQual{{"<", defaultTime.Add(time.Hour)}}, defaultTime},
+		{"the latest lower bound", []timestampQual{{">=", defaultTime.Add(time.Hour)}, {">", defaultTime.Add(2 * time.Hour)}}, defaultTime.Add(2*time.Hour + time.Millisecond)},
+		{"the earliest upper bound", []timestampQual{{"<=", t1}, {"<", t1.Add(-time.Hour)}}, t1.Add(-time.Hour - time.Millisecond)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			d := newTimestampQueryData("start_time", test.quals)
+			if got := getMonitoringMetricQualTime(d, "start_time", defaultTime); !got.Equal(test.want) {
+				t.Errorf("getMonitoringMetricQualTime() = %s, want %s", got, test.want)
+			}
+		})
+	}
+}
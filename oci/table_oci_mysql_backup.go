@@ -52,7 +52,7 @@ func tableMySQLBackup(_ context.Context) *plugin.Table {
 			Hydrate:    getMySQLBackup,
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
-		Columns: []*plugin.Column{
+		Columns: CommonColumns([]*plugin.Column{
 			{
 				Name:        "display_name",
 				Description: "A user-supplied display name for the backup.",
@@ -156,27 +156,7 @@ func tableMySQLBackup(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 			},
 
-			// Steampipe standard columns
-			{
-				Name:        "tags",
-				Description: ColumnDescriptionTags,
-				Type:        proto.ColumnType_JSON,
-				Transform:   transform.From(backupTags),
-			},
-			{
-				Name:        "title",
-				Description: ColumnDescriptionTitle,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("DisplayName"),
-			},
-
 			// OCI standard columns
-			{
-				Name:        "region",
-				Description: ColumnDescriptionRegion,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("Id").Transform(ociRegionName),
-			},
 			{
 				Name:        "compartment_id",
 				Description: ColumnDescriptionCompartment,
@@ -184,14 +164,7 @@ func tableMySQLBackup(_ context.Context) *plugin.Table {
 				Hydrate:     getMySQLBackup,
 				Transform:   transform.FromField("CompartmentId"),
 			},
-			{
-				Name:        "tenant_id",
-				Description: ColumnDescriptionTenant,
-				Type:        proto.ColumnType_STRING,
-				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
-				Transform:   transform.FromValue(),
-			},
-		},
+		}),
 	}
 }
 
@@ -235,7 +208,7 @@ func listMySQLBackups(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydrat
 	for pagesLeft {
 		response, err := session.MySQLBackupClient.ListBackups(ctx, request)
 		if err != nil {
-			return nil, err
+			return nil, wrapServiceError(err, session, "ListBackups")
 		}
 
 		for _, dbBackup := range response.Items {
@@ -295,7 +268,7 @@ func getMySQLBackup(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateD
 
 	response, err := session.MySQLBackupClient.GetBackup(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetBackup")
 	}
 
 	return response.Backup, nil
@@ -303,35 +276,6 @@ func getMySQLBackup(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateD
 
 //// TRANSFORM FUNCTION
 
-func backupTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
-	freeformTags := backupFreeformTags(d.HydrateItem)
-
-	var tags map[string]interface{}
-
-	if freeformTags != nil {
-		tags = map[string]interface{}{}
-		for k, v := range freeformTags {
-			tags[k] = v
-		}
-	}
-
-	definedTags := backupDefinedTags(d.HydrateItem)
-
-	if definedTags != nil {
-		if tags == nil {
-			tags = map[string]interface{}{}
-		}
-		for _, v := range definedTags {
-			for key, value := range v {
-				tags[key] = value
-			}
-
-		}
-	}
-
-	return tags, nil
-}
-
 func backupFreeformTags(item interface{}) map[string]string {
 	switch item := item.(type) {
 	case mysql.Backup:
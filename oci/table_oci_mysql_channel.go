@@ -48,7 +48,7 @@ func tableMySQLChannel(_ context.Context) *plugin.Table {
 			Hydrate:    getMySQLChannel,
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
-		Columns: []*plugin.Column{
+		Columns: CommonColumns([]*plugin.Column{
 			{
 				Name:        "display_name",
 				Description: "The user-friendly name for the Channel. It does not have to be unique.",
@@ -120,27 +120,7 @@ func tableMySQLChannel(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 			},
 
-			// Steampipe standard columns
-			{
-				Name:        "tags",
-				Description: ColumnDescriptionTags,
-				Type:        proto.ColumnType_JSON,
-				Transform:   transform.From(channelTags),
-			},
-			{
-				Name:        "title",
-				Description: ColumnDescriptionTitle,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("DisplayName"),
-			},
-
 			// OCI standard columns
-			{
-				Name:        "region",
-				Description: ColumnDescriptionRegion,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("Id").Transform(ociRegionName),
-			},
 			{
 				Name:        "compartment_id",
 				Description: ColumnDescriptionCompartment,
@@ -148,14 +128,7 @@ func tableMySQLChannel(_ context.Context) *plugin.Table {
 				Hydrate:     getMySQLChannel,
 				Transform:   transform.FromField("CompartmentId"),
 			},
-			{
-				Name:        "tenant_id",
-				Description: ColumnDescriptionTenant,
-				Type:        proto.ColumnType_STRING,
-				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
-				Transform:   transform.FromValue(),
-			},
-		},
+		}),
 	}
 }
 
@@ -199,7 +172,7 @@ func listMySQLChannels(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydra
 	for pagesLeft {
 		response, err := session.MySQLChannelClient.ListChannels(ctx, request)
 		if err != nil {
-			return nil, err
+			return nil, wrapServiceError(err, session, "ListChannels")
 		}
 
 		for _, channel := range response.Items {
@@ -259,7 +232,7 @@ func getMySQLChannel(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrate
 
 	response, err := session.MySQLChannelClient.GetChannel(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetChannel")
 	}
 
 	return response.Channel, nil
@@ -267,35 +240,6 @@ func getMySQLChannel(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrate
 
 //// TRANSFORM FUNCTION
 
-func channelTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
-	freeformTags := channelFreeformTags(d.HydrateItem)
-
-	var tags map[string]interface{}
-
-	if freeformTags != nil {
-		tags = map[string]interface{}{}
-		for k, v := range freeformTags {
-			tags[k] = v
-		}
-	}
-
-	definedTags := channelDefinedTags(d.HydrateItem)
-
-	if definedTags != nil {
-		if tags == nil {
-			tags = map[string]interface{}{}
-		}
-		for _, v := range definedTags {
-			for key, value := range v {
-				tags[key] = value
-			}
-
-		}
-	}
-
-	return tags, nil
-}
-
 func channelFreeformTags(item interface{}) map[string]string {
 	switch item := item.(type) {
 	case mysql.Channel:
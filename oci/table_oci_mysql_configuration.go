@@ -48,7 +48,7 @@ func tableMySQLConfiguration(_ context.Context) *plugin.Table {
 			},
 		},
 		GetMatrixItemFunc: BuildCompartmentList,
-		Columns: []*plugin.Column{
+		Columns: CommonColumns([]*plugin.Column{
 			{
 				Name:        "display_name",
 				Description: "The display name of the Configuration.",
@@ -120,36 +120,15 @@ func tableMySQLConfiguration(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 			},
 
-			// Steampipe standard columns
-			{
-				Name:        "tags",
-				Description: ColumnDescriptionTags,
-				Type:        proto.ColumnType_JSON,
-				Transform:   transform.From(mySQLConfigurationTags),
-			},
-			{
-				Name:        "title",
-				Description: ColumnDescriptionTitle,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("DisplayName"),
-			},
-
 			// OCI standard columns
 			{
 				Name:        "compartment_id",
 				Description: ColumnDescriptionCompartment,
 				Type:        proto.ColumnType_STRING,
-				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
+				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(tenancyCacheKey("getTenantId")),
 				Transform:   transform.FromValue(),
 			},
-			{
-				Name:        "tenant_id",
-				Description: ColumnDescriptionTenant,
-				Type:        proto.ColumnType_STRING,
-				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
-				Transform:   transform.FromValue(),
-			},
-		},
+		}),
 	}
 }
 
@@ -198,7 +177,7 @@ func listMySQLConfigurations(ctx context.Context, d *plugin.QueryData, _ *plugin
 	for pagesLeft {
 		response, err := session.MySQLConfigurationClient.ListConfigurations(ctx, request)
 		if err != nil {
-			return nil, err
+			return nil, wrapServiceError(err, session, "ListConfigurations")
 		}
 		for _, configuration := range response.Items {
 			d.StreamListItem(ctx, configuration)
@@ -252,49 +231,8 @@ func getMySQLConfiguration(ctx context.Context, d *plugin.QueryData, h *plugin.H
 
 	response, err := session.MySQLConfigurationClient.GetConfiguration(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetConfiguration")
 	}
 
 	return response.Configuration, nil
 }
-
-//// TRANSFORM FUNCTION
-
-func mySQLConfigurationTags(ctx context.Context, d *transform.TransformData) (interface{}, error) {
-	var freeformTags map[string]string
-	var definedTags map[string]map[string]interface{}
-
-	switch d.HydrateItem.(type) {
-	case mysql.Configuration:
-		configuration := d.HydrateItem.(mysql.Configuration)
-		freeformTags = configuration.FreeformTags
-		definedTags = configuration.DefinedTags
-	case mysql.ConfigurationSummary:
-		configuration := d.HydrateItem.(mysql.ConfigurationSummary)
-		freeformTags = configuration.FreeformTags
-		definedTags = configuration.DefinedTags
-	}
-
-	var tags map[string]interface{}
-
-	if freeformTags != nil {
-		tags = map[string]interface{}{}
-		for k, v := range freeformTags {
-			tags[k] = v
-		}
-	}
-
-	if definedTags != nil {
-		if tags == nil {
-			tags = map[string]interface{}{}
-		}
-		for _, v := range definedTags {
-			for key, value := range v {
-				tags[key] = value
-			}
-
-		}
-	}
-
-	return tags, nil
-}
@@ -48,7 +48,7 @@ func tableMySQLConfigurationCustom(_ context.Context) *plugin.Table {
 			},
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
-		Columns: []*plugin.Column{
+		Columns: CommonColumns([]*plugin.Column{
 			{
 				Name:        "display_name",
 				Description: "The display name of the configuration.",
@@ -119,42 +119,7 @@ func tableMySQLConfigurationCustom(_ context.Context) *plugin.Table {
 				Description: ColumnDescriptionFreefromTags,
 				Type:        proto.ColumnType_JSON,
 			},
-
-			// Steampipe standard columns
-			{
-				Name:        "tags",
-				Description: ColumnDescriptionTags,
-				Type:        proto.ColumnType_JSON,
-				Transform:   transform.From(mySQLConfigurationTags),
-			},
-			{
-				Name:        "title",
-				Description: ColumnDescriptionTitle,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("DisplayName"),
-			},
-
-			// OCI standard columns
-			{
-				Name:        "region",
-				Description: ColumnDescriptionRegion,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("Id").Transform(ociRegionName),
-			},
-			{
-				Name:        "compartment_id",
-				Description: ColumnDescriptionCompartment,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("CompartmentId"),
-			},
-			{
-				Name:        "tenant_id",
-				Description: ColumnDescriptionTenant,
-				Type:        proto.ColumnType_STRING,
-				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
-				Transform:   transform.FromValue(),
-			},
-		},
+		}),
 	}
 }
 
@@ -199,7 +164,7 @@ func listMySQLCustomConfigurations(ctx context.Context, d *plugin.QueryData, _ *
 	for pagesLeft {
 		response, err := session.MySQLConfigurationClient.ListConfigurations(ctx, request)
 		if err != nil {
-			return nil, err
+			return nil, wrapServiceError(err, session, "ListConfigurations")
 		}
 		for _, configuration := range response.Items {
 			d.StreamListItem(ctx, configuration)
@@ -259,7 +224,7 @@ func getCustomConfiguration(ctx context.Context, d *plugin.QueryData, h *plugin.
 
 	response, err := session.MySQLConfigurationClient.GetConfiguration(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetConfiguration")
 	}
 
 	return response.Configuration, nil
@@ -2,9 +2,7 @@ package oci
 
 import (
 	"context"
-	"fmt"
 
-	"github.com/oracle/oci-go-sdk/v44/mysql"
 	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
@@ -17,8 +15,8 @@ func tableOciMySQLDBSystemMetricCpuUtilization(_ context.Context) *plugin.Table
 		Name:        "oci_mysql_db_system_metric_cpu_utilization",
 		Description: "OCI MySQL DB System Monitoring Metrics - CPU Utilization",
 		List: &plugin.ListConfig{
-			ParentHydrate: listMySQLDBSystems,
-			Hydrate:       listMySQLDBSystemMetricCpuUtilization,
+			Hydrate:    listMySQLDBSystemMetricCpuUtilization,
+			KeyColumns: MonitoringMetricKeyColumns(),
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
 		Columns: MonitoringMetricColumns(
@@ -33,13 +31,6 @@ func tableOciMySQLDBSystemMetricCpuUtilization(_ context.Context) *plugin.Table
 	}
 }
 
-func listMySQLDBSystemMetricCpuUtilization(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
-	dbSystem := h.Item.(mysql.DbSystemSummary)
-	region := fmt.Sprintf("%v", ociRegionNameFromId(*dbSystem.Id))
-
-	if dbSystem.LifecycleState == "DELETING" || dbSystem.LifecycleState == "DELETED" {
-		return nil, nil
-	}
-
-	return listMonitoringMetricStatistics(ctx, d, "5_MIN", "oci_mysql_database", "CPUUtilization", "resourceId", *dbSystem.Id, *dbSystem.CompartmentId, region)
+func listMySQLDBSystemMetricCpuUtilization(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	return listMonitoringMetricStatistics(ctx, d, "5_MIN", "oci_mysql_database", "CPUUtilization", "resourceId", "")
 }
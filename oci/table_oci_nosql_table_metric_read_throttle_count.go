@@ -2,9 +2,7 @@ package oci
 
 import (
 	"context"
-	"fmt"
 
-	"github.com/oracle/oci-go-sdk/v44/nosql"
 	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
@@ -16,8 +14,8 @@ func tableOciNoSQLTableMetricReadThrottleCount(_ context.Context) *plugin.Table
 		Name:        "oci_nosql_table_metric_read_throttle_count",
 		Description: "OCI NoSQL Table Monitoring Metrics - Read Throttle Count",
 		List: &plugin.ListConfig{
-			ParentHydrate: listNoSQLTables,
-			Hydrate:       listNoSQLTableMetricReadThrottleCount,
+			Hydrate:    listNoSQLTableMetricReadThrottleCount,
+			KeyColumns: MonitoringMetricKeyColumns(),
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
 		Columns: MonitoringMetricColumns(
@@ -32,8 +30,6 @@ func tableOciNoSQLTableMetricReadThrottleCount(_ context.Context) *plugin.Table
 	}
 }
 
-func listNoSQLTableMetricReadThrottleCount(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
-	table := h.Item.(nosql.TableSummary)
-	region := fmt.Sprintf("%v", ociRegionNameFromId(*table.Id))
-	return listMonitoringMetricStatistics(ctx, d, "5_MIN", "oci_nosql", "ReadThrottleCount", "tableName", *table.Name, *table.CompartmentId, region)
+func listNoSQLTableMetricReadThrottleCount(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	return listMonitoringMetricStatistics(ctx, d, "5_MIN", "oci_nosql", "ReadThrottleCount", "tableName", "")
 }
@@ -2,9 +2,7 @@ package oci
 
 import (
 	"context"
-	"fmt"
 
-	"github.com/oracle/oci-go-sdk/v44/nosql"
 	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
@@ -16,8 +14,8 @@ func tableOciNoSQLTableMetricReadThrottleCountDaily(_ context.Context) *plugin.T
 		Name:        "oci_nosql_table_metric_read_throttle_count_daily",
 		Description: "OCI NoSQL Table Monitoring Metrics - Read Throttle Count (Daily)",
 		List: &plugin.ListConfig{
-			ParentHydrate: listNoSQLTables,
-			Hydrate:       listNoSQLTableMetricReadThrottleCountDaily,
+			Hydrate:    listNoSQLTableMetricReadThrottleCountDaily,
+			KeyColumns: MonitoringMetricKeyColumns(),
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
 		Columns: MonitoringMetricColumns(
@@ -32,8 +30,6 @@ func tableOciNoSQLTableMetricReadThrottleCountDaily(_ context.Context) *plugin.T
 	}
 }
 
-func listNoSQLTableMetricReadThrottleCountDaily(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
-	table := h.Item.(nosql.TableSummary)
-	region := fmt.Sprintf("%v", ociRegionNameFromId(*table.Id))
-	return listMonitoringMetricStatistics(ctx, d, "DAILY", "oci_nosql", "ReadThrottleCount", "tableName", *table.Name, *table.CompartmentId, region)
+func listNoSQLTableMetricReadThrottleCountDaily(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	return listMonitoringMetricStatistics(ctx, d, "DAILY", "oci_nosql", "ReadThrottleCount", "tableName", "")
 }
@@ -22,9 +22,9 @@ func tableObjectStorageBucket(_ context.Context) *plugin.Table {
 		Description: "OCI ObjectStorage Bucket",
 		// Bucket can have same name in two different compartments, leads to duplicate result in get call
 		// Get: &plugin.GetConfig{
-		// 	KeyColumns:        plugin.SingleColumn("name"),
-		// 	ShouldIgnoreError: isNotFoundError([]string{"400", "404"}),
-		// 	Hydrate:           getObjectStorageBucket,
+		// 	KeyColumns:   plugin.SingleColumn("name"),
+		// 	IgnoreConfig: &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: isNotFoundError([]string{"400", "404"})},
+		// 	Hydrate:      getObjectStorageBucket,
 		// },
 		List: &plugin.ListConfig{
 			Hydrate: listObjectStorageBuckets,
@@ -40,7 +40,7 @@ func tableObjectStorageBucket(_ context.Context) *plugin.Table {
 			},
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
-		Columns: []*plugin.Column{
+		Columns: CommonColumns([]*plugin.Column{
 			{
 				Name:        "name",
 				Description: "The name of the bucket.",
@@ -170,7 +170,7 @@ func tableObjectStorageBucket(_ context.Context) *plugin.Table {
 				Name:        "tags",
 				Description: ColumnDescriptionTags,
 				Type:        proto.ColumnType_JSON,
-				Transform:   transform.From(bucketTags),
+				Transform:   transform.From(commonTags),
 				Hydrate:     getObjectStorageBucket,
 			},
 			{
@@ -186,20 +186,7 @@ func tableObjectStorageBucket(_ context.Context) *plugin.Table {
 				Description: ColumnDescriptionRegion,
 				Type:        proto.ColumnType_STRING,
 			},
-			{
-				Name:        "compartment_id",
-				Description: ColumnDescriptionCompartment,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("CompartmentId"),
-			},
-			{
-				Name:        "tenant_id",
-				Description: ColumnDescriptionTenant,
-				Type:        proto.ColumnType_STRING,
-				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
-				Transform:   transform.FromValue(),
-			},
-		},
+		}),
 	}
 }
 
@@ -258,7 +245,7 @@ func listObjectStorageBuckets(ctx context.Context, d *plugin.QueryData, _ *plugi
 	for pagesLeft {
 		response, err := session.ObjectStorageClient.ListBuckets(ctx, request)
 		if err != nil {
-			return nil, err
+			return nil, wrapServiceError(err, session, "ListBuckets")
 		}
 
 		for _, bucketSummary := range response.Items {
@@ -328,7 +315,7 @@ func getObjectStorageBucket(ctx context.Context, d *plugin.QueryData, h *plugin.
 	}
 	response, err := session.ObjectStorageClient.GetBucket(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetBucket")
 	}
 
 	return response.Bucket, nil
@@ -365,35 +352,3 @@ func getObjectStorageBucketObjectLifecycle(ctx context.Context, d *plugin.QueryD
 
 	return response.ObjectLifecyclePolicy, nil
 }
-
-//// TRANSFORM FUNCTION
-
-// Priority order for tags
-// 1. System Tags
-// 2. Defined Tags
-// 3. Free-form tags
-func bucketTags(ctx context.Context, d *transform.TransformData) (interface{}, error) {
-	bucket := d.HydrateItem.(objectstorage.Bucket)
-
-	var tags map[string]interface{}
-	if bucket.FreeformTags != nil {
-		tags = map[string]interface{}{}
-		for k, v := range bucket.FreeformTags {
-			tags[k] = v
-		}
-	}
-
-	if bucket.DefinedTags != nil {
-		if tags == nil {
-			tags = map[string]interface{}{}
-		}
-		for _, v := range bucket.DefinedTags {
-			for key, value := range v {
-				tags[key] = value
-			}
-
-		}
-	}
-
-	return tags, nil
-}
@@ -23,7 +23,7 @@ func tableObjectStorageObject(_ context.Context) *plugin.Table {
 			ParentHydrate: listObjectStorageBuckets,
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
-		Columns: []*plugin.Column{
+		Columns: CommonColumns([]*plugin.Column{
 			{
 				Name:        "name",
 				Description: "The name of the object.",
@@ -167,14 +167,7 @@ func tableObjectStorageObject(_ context.Context) *plugin.Table {
 				Description: ColumnDescriptionRegion,
 				Type:        proto.ColumnType_STRING,
 			},
-			{
-				Name:        "tenant_id",
-				Description: ColumnDescriptionTenant,
-				Type:        proto.ColumnType_STRING,
-				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
-				Transform:   transform.FromValue(),
-			},
-		},
+		}),
 	}
 }
 
@@ -226,7 +219,7 @@ func listObjectStorageObjects(ctx context.Context, d *plugin.QueryData, h *plugi
 	response, err := session.ObjectStorageClient.ListObjects(ctx, request)
 	if err != nil {
 		logger.Error("listObjectStorageObjects", "error_ListObjects", err)
-		return nil, err
+		return nil, wrapServiceError(err, session, "ListObjects")
 	}
 
 	for _, objectSummary := range response.Objects {
@@ -276,7 +269,7 @@ func getObjectStorageObject(ctx context.Context, d *plugin.QueryData, h *plugin.
 				return nil, nil
 			}
 		}
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetObject")
 	}
 
 	return response, nil
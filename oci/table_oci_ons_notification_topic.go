@@ -40,7 +40,7 @@ func tableOnsNotificationTopic(_ context.Context) *plugin.Table {
 			},
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
-		Columns: []*plugin.Column{
+		Columns: CommonColumns([]*plugin.Column{
 			{
 				Name:        "name",
 				Description: "The name of the topic.",
@@ -101,12 +101,6 @@ func tableOnsNotificationTopic(_ context.Context) *plugin.Table {
 			},
 
 			// Standard Steampipe columns
-			{
-				Name:        "tags",
-				Description: ColumnDescriptionTags,
-				Type:        proto.ColumnType_JSON,
-				Transform:   transform.From(topicTags),
-			},
 			{
 				Name:        "title",
 				Description: ColumnDescriptionTitle,
@@ -121,20 +115,7 @@ func tableOnsNotificationTopic(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("TopicId").Transform(ociRegionName),
 			},
-			{
-				Name:        "compartment_id",
-				Description: ColumnDescriptionCompartment,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("CompartmentId"),
-			},
-			{
-				Name:        "tenant_id",
-				Description: ColumnDescriptionTenant,
-				Type:        proto.ColumnType_STRING,
-				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
-				Transform:   transform.FromValue(),
-			},
-		},
+		}),
 	}
 }
 
@@ -187,7 +168,7 @@ func listOnsNotificationTopics(ctx context.Context, d *plugin.QueryData, _ *plug
 	for pagesLeft {
 		response, err := session.NotificationControlPlaneClient.ListTopics(ctx, request)
 		if err != nil {
-			return nil, err
+			return nil, wrapServiceError(err, session, "ListTopics")
 		}
 
 		for _, topic := range response.Items {
@@ -244,7 +225,7 @@ func getOnsNotificationTopic(ctx context.Context, d *plugin.QueryData, h *plugin
 
 	response, err := session.NotificationControlPlaneClient.GetTopic(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetTopic")
 	}
 
 	return response.NotificationTopic, nil
@@ -252,35 +233,6 @@ func getOnsNotificationTopic(ctx context.Context, d *plugin.QueryData, h *plugin
 
 //// TRANSFORM FUNCTION
 
-func topicTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
-	freeformTags := topicFreeformTags(d.HydrateItem)
-
-	var tags map[string]interface{}
-
-	if freeformTags != nil {
-		tags = map[string]interface{}{}
-		for k, v := range freeformTags {
-			tags[k] = v
-		}
-	}
-
-	definedTags := topicDefinedTags(d.HydrateItem)
-
-	if definedTags != nil {
-		if tags == nil {
-			tags = map[string]interface{}{}
-		}
-		for _, v := range definedTags {
-			for key, value := range v {
-				tags[key] = value
-			}
-
-		}
-	}
-
-	return tags, nil
-}
-
 func topicFreeformTags(item interface{}) map[string]string {
 	switch item := item.(type) {
 	case ons.NotificationTopic:
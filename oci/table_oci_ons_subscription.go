@@ -4,7 +4,6 @@ import (
 	"context"
 	"strings"
 
-
 	"github.com/oracle/oci-go-sdk/v44/common"
 	"github.com/oracle/oci-go-sdk/v44/ons"
 	"github.com/turbot/go-kit/types"
@@ -20,9 +19,9 @@ func tableOnsSubscription(_ context.Context) *plugin.Table {
 		Name:        "oci_ons_subscription",
 		Description: "OCI Ons Subscription",
 		Get: &plugin.GetConfig{
-			KeyColumns:        plugin.SingleColumn("id"),
-			ShouldIgnoreError: isNotFoundError([]string{"400", "404"}),
-			Hydrate:           getOnsSubscription,
+			KeyColumns:   plugin.SingleColumn("id"),
+			IgnoreConfig: &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: isNotFoundError([]string{"400", "404"})},
+			Hydrate:      getOnsSubscription,
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listOnsSubscriptions,
@@ -38,7 +37,7 @@ func tableOnsSubscription(_ context.Context) *plugin.Table {
 			},
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
-		Columns: []*plugin.Column{
+		Columns: CommonColumns([]*plugin.Column{
 			{
 				Name:        "id",
 				Description: "The OCID of the subscription.",
@@ -100,40 +99,13 @@ func tableOnsSubscription(_ context.Context) *plugin.Table {
 			},
 
 			// Standard Steampipe columns
-			{
-				Name:        "tags",
-				Description: ColumnDescriptionTags,
-				Type:        proto.ColumnType_JSON,
-				Transform:   transform.From(subscriptionTags),
-			},
 			{
 				Name:        "title",
 				Description: ColumnDescriptionTitle,
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("Endpoint"),
 			},
-
-			// Standard OCI columns
-			{
-				Name:        "region",
-				Description: ColumnDescriptionRegion,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("Id").Transform(ociRegionName),
-			},
-			{
-				Name:        "compartment_id",
-				Description: ColumnDescriptionCompartment,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("CompartmentId"),
-			},
-			{
-				Name:        "tenant_id",
-				Description: ColumnDescriptionTenant,
-				Type:        proto.ColumnType_STRING,
-				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
-				Transform:   transform.FromValue(),
-			},
-		},
+		}),
 	}
 }
 
@@ -183,7 +155,7 @@ func listOnsSubscriptions(ctx context.Context, d *plugin.QueryData, _ *plugin.Hy
 	for pagesLeft {
 		response, err := session.NotificationDataPlaneClient.ListSubscriptions(ctx, request)
 		if err != nil {
-			return nil, err
+			return nil, wrapServiceError(err, session, "ListSubscriptions")
 		}
 
 		for _, subscription := range response.Items {
@@ -240,7 +212,7 @@ func getOnsSubscription(ctx context.Context, d *plugin.QueryData, h *plugin.Hydr
 
 	response, err := session.NotificationDataPlaneClient.GetSubscription(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetSubscription")
 	}
 
 	return response.Subscription, nil
@@ -293,7 +265,7 @@ func getSubscriptionDeliveryPolicy(ctx context.Context, d *plugin.QueryData, h *
 	for pagesLeft {
 		response, err := session.NotificationDataPlaneClient.ListSubscriptions(ctx, request)
 		if err != nil {
-			return nil, err
+			return nil, wrapServiceError(err, session, "ListSubscriptions")
 		}
 
 		for _, subscription := range response.Items {
@@ -318,38 +290,8 @@ func getSubscriptionDeliveryPolicy(ctx context.Context, d *plugin.QueryData, h *
 	return nil, err
 }
 
-
 //// TRANSFORM FUNCTION
 
-func subscriptionTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
-	freeformTags := subscriptionFreeformTags(d.HydrateItem)
-
-	var tags map[string]interface{}
-
-	if freeformTags != nil {
-		tags = map[string]interface{}{}
-		for k, v := range freeformTags {
-			tags[k] = v
-		}
-	}
-
-	definedTags := subscriptionDefinedTags(d.HydrateItem)
-
-	if definedTags != nil {
-		if tags == nil {
-			tags = map[string]interface{}{}
-		}
-		for _, v := range definedTags {
-			for key, value := range v {
-				tags[key] = value
-			}
-
-		}
-	}
-
-	return tags, nil
-}
-
 func subscriptionFreeformTags(item interface{}) map[string]string {
 	switch item := item.(type) {
 	case ons.Subscription:
@@ -0,0 +1,117 @@
+package oci
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tablePluginApiCall(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "oci_plugin_api_call",
+		Description: "OCI Plugin API Call",
+		List: &plugin.ListConfig{
+			Hydrate: listPluginApiCalls,
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "service",
+				Description: "The name of the service the requests were sent to, e.g. compute or identity.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "operation",
+				Description: "The HTTP method and path of the requests, with resource OCIDs replaced by {id}.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "region",
+				Description: "The region the requests were sent to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "compartment_id",
+				Description: "The OCID of the compartment the requests were scoped to, if any.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tenancy",
+				Description: "The name of the tenancy in the connection config. Empty for connections without a tenancies list.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "calls",
+				Description: "The number of requests sent, including retries.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "errors",
+				Description: "The number of requests which failed, with an error response or a network error.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "throttles",
+				Description: "The number of requests which were throttled by the service (HTTP 429).",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "retries",
+				Description: "The number of requests which failed with a retryable error, and were sent again unless the retry attempts were exhausted.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "error_codes",
+				Description: "The number of failed requests per OCI error code, HTTP status or network error.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "latency_p50_ms",
+				Description: "The median latency of the requests in milliseconds.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "latency_p90_ms",
+				Description: "The 90th percentile latency of the requests in milliseconds.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "latency_p99_ms",
+				Description: "The 99th percentile latency of the requests in milliseconds.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "latency_max_ms",
+				Description: "The highest latency of the sampled requests in milliseconds.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "first_call_at",
+				Description: "The time the first request was sent.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "last_call_at",
+				Description: "The time the last request was sent.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listPluginApiCalls(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	for _, item := range getApiCalls(d.Connection.Name) {
+		d.StreamListItem(ctx, item)
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.QueryStatus.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
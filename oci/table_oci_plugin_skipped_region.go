@@ -0,0 +1,67 @@
+package oci
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tablePluginSkippedRegion(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "oci_plugin_skipped_region",
+		Description: "OCI Plugin Skipped Region",
+		List: &plugin.ListConfig{
+			Hydrate: listPluginSkippedRegions,
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "matrix",
+				Description: "The name of the matrix function which skipped the region, e.g. BuildCompartementRegionList.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tenancy",
+				Description: "The name of the tenancy in the connection config. Empty for connections without a tenancies list.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "region",
+				Description: "The region that was skipped. Empty if the whole tenancy was skipped.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "error_code",
+				Description: "The OCI error code of the failure, or Timeout/Unreachable for network errors.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "error_message",
+				Description: "The error returned while listing the region.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "skipped_at",
+				Description: "The time the region was skipped.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listPluginSkippedRegions(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	for _, item := range getSkippedRegions(d.Connection.Name) {
+		d.StreamListItem(ctx, item)
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.QueryStatus.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -2,6 +2,7 @@ package oci
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/oracle/oci-go-sdk/v44/identity"
 	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
@@ -18,6 +19,7 @@ func tableIdentityRegion(_ context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: listRegions,
 		},
+		GetMatrixItemFunc: BuildTenancyList,
 		Columns: []*plugin.Column{
 			{
 				Name:        "name",
@@ -58,7 +60,7 @@ func tableIdentityRegion(_ context.Context) *plugin.Table {
 				Name:        "tenant_id",
 				Description: ColumnDescriptionTenant,
 				Type:        proto.ColumnType_STRING,
-				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
+				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(tenancyCacheKey("getTenantId")),
 				Transform:   transform.FromValue(),
 			},
 		},
@@ -82,7 +84,7 @@ func listRegions(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData
 	// List all the regions for the tenant
 	regions, err := session.IdentityClient.ListRegions(ctx)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "ListRegions")
 	}
 
 	request := identity.ListRegionSubscriptionsRequest{
@@ -92,7 +94,7 @@ func listRegions(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData
 	// List all the subscribed regions for the tenant
 	subscribedRegions, err := session.IdentityClient.ListRegionSubscriptions(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "ListRegionSubscriptions")
 	}
 
 	for _, region := range regions.Items {
@@ -117,7 +119,7 @@ func listRegions(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData
 
 func getTenantId(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	plugin.Logger(ctx).Trace("getTenantId")
-	cacheKey := "getTenantId"
+	cacheKey := fmt.Sprintf("getTenantId-%s", getTenancyName(ctx))
 
 	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
 		return cachedData.(string), nil
@@ -23,7 +23,7 @@ func tableResourceSearch(_ context.Context) *plugin.Table {
 			Hydrate:    listResourceSearch,
 		},
 		GetMatrixItemFunc: BuildRegionList,
-		Columns: []*plugin.Column{
+		Columns: CommonColumns([]*plugin.Column{
 			{
 				Name:        "identifier",
 				Description: "The unique identifier for this particular resource, usually an OCID.",
@@ -94,20 +94,6 @@ func tableResourceSearch(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 			},
 
-			// Standard Steampipe columns
-			{
-				Name:        "tags",
-				Description: ColumnDescriptionTags,
-				Type:        proto.ColumnType_JSON,
-				Transform:   transform.From(resourceSearchTags),
-			},
-			{
-				Name:        "title",
-				Description: ColumnDescriptionTitle,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("DisplayName"),
-			},
-
 			// Standard OCI columns
 			{
 				Name:        "search_region",
@@ -115,20 +101,7 @@ func tableResourceSearch(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("Region"),
 			},
-			{
-				Name:        "compartment_id",
-				Description: ColumnDescriptionCompartment,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("CompartmentId"),
-			},
-			{
-				Name:        "tenant_id",
-				Description: ColumnDescriptionTenant,
-				Type:        proto.ColumnType_STRING,
-				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
-				Transform:   transform.FromValue(),
-			},
-		},
+		}),
 	}
 }
 
@@ -187,7 +160,7 @@ func listResourceSearch(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydr
 		for pagesLeft {
 			response, err := session.ResourceSearchClient.SearchResources(ctx, request)
 			if err != nil {
-				return nil, err
+				return nil, wrapServiceError(err, session, "SearchResources")
 			}
 
 			for _, resource := range response.Items {
@@ -219,7 +192,7 @@ func listResourceSearch(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydr
 		for pagesLeft {
 			response, err := session.ResourceSearchClient.SearchResources(ctx, request)
 			if err != nil {
-				return nil, err
+				return nil, wrapServiceError(err, session, "SearchResources")
 			}
 
 			for _, resource := range response.Items {
@@ -235,48 +208,3 @@ func listResourceSearch(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydr
 
 	return nil, err
 }
-
-//// TRANSFORM FUNCTION
-
-// Priority order for tags
-// 1. System Tags
-// 2. Defined Tags
-// 3. Free-form tags
-func resourceSearchTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
-	resourceSearch := d.HydrateItem.(searchInfo)
-
-	var tags map[string]interface{}
-
-	if resourceSearch.FreeformTags != nil {
-		tags = map[string]interface{}{}
-		for k, v := range resourceSearch.FreeformTags {
-			tags[k] = v
-		}
-	}
-
-	if resourceSearch.DefinedTags != nil {
-		if tags == nil {
-			tags = map[string]interface{}{}
-		}
-		for _, v := range resourceSearch.DefinedTags {
-			for key, value := range v {
-				tags[key] = value
-			}
-
-		}
-	}
-
-	if resourceSearch.SystemTags != nil {
-		if tags == nil {
-			tags = map[string]interface{}{}
-		}
-		for _, v := range resourceSearch.SystemTags {
-			for key, value := range v {
-				tags[key] = value
-			}
-
-		}
-	}
-
-	return tags, nil
-}
@@ -40,7 +40,7 @@ func tableOciResourceManagerStack(_ context.Context) *plugin.Table {
 			},
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
-		Columns: []*plugin.Column{
+		Columns: CommonColumns([]*plugin.Column{
 			{
 				Name:        "display_name",
 				Description: "Human-readable display name for the stack.",
@@ -110,42 +110,7 @@ func tableOciResourceManagerStack(_ context.Context) *plugin.Table {
 				Description: ColumnDescriptionFreefromTags,
 				Type:        proto.ColumnType_JSON,
 			},
-
-			// Steampipe standard columns
-			{
-				Name:        "tags",
-				Description: ColumnDescriptionTags,
-				Type:        proto.ColumnType_JSON,
-				Transform:   transform.From(resourceManagerStackTags),
-			},
-			{
-				Name:        "title",
-				Description: ColumnDescriptionTitle,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("DisplayName"),
-			},
-
-			// OCI standard columns
-			{
-				Name:        "region",
-				Description: ColumnDescriptionRegion,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("Id").Transform(ociRegionName),
-			},
-			{
-				Name:        "compartment_id",
-				Description: ColumnDescriptionCompartment,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("CompartmentId"),
-			},
-			{
-				Name:        "tenant_id",
-				Description: ColumnDescriptionTenant,
-				Type:        proto.ColumnType_STRING,
-				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
-				Transform:   transform.FromValue(),
-			},
-		},
+		}),
 	}
 }
 
@@ -204,7 +169,7 @@ func listResourceManagerStacks(ctx context.Context, d *plugin.QueryData, _ *plug
 	for pagesLeft {
 		response, err := session.ResourceManagerClient.ListStacks(ctx, request)
 		if err != nil {
-			return nil, err
+			return nil, wrapServiceError(err, session, "ListStacks")
 		}
 		for _, resource := range response.Items {
 			d.StreamListItem(ctx, resource)
@@ -262,7 +227,7 @@ func getResourceManagerStack(ctx context.Context, d *plugin.QueryData, h *plugin
 
 	response, err := session.ResourceManagerClient.GetStack(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetStack")
 	}
 
 	return response.Stack, nil
@@ -270,45 +235,6 @@ func getResourceManagerStack(ctx context.Context, d *plugin.QueryData, h *plugin
 
 //// TRANSFORM FUNCTION
 
-func resourceManagerStackTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
-	var freeformTags map[string]string
-	var definedTags map[string]map[string]interface{}
-
-	switch d.HydrateItem.(type) {
-	case resourcemanager.StackSummary:
-		stacks := d.HydrateItem.(resourcemanager.StackSummary)
-		freeformTags = stacks.FreeformTags
-		definedTags = stacks.DefinedTags
-	case resourcemanager.Stack:
-		stack := d.HydrateItem.(resourcemanager.Stack)
-		freeformTags = stack.FreeformTags
-		definedTags = stack.DefinedTags
-	}
-
-	var tags map[string]interface{}
-
-	if freeformTags != nil {
-		tags = map[string]interface{}{}
-		for k, v := range freeformTags {
-			tags[k] = v
-		}
-	}
-
-	if definedTags != nil {
-		if tags == nil {
-			tags = map[string]interface{}{}
-		}
-		for _, v := range definedTags {
-			for key, value := range v {
-				tags[key] = value
-			}
-
-		}
-	}
-
-	return tags, nil
-}
-
 func isValidResourceManagerStackLifecycleState(state string) bool {
 	stateType := resourcemanager.StackLifecycleStateEnum(state)
 	switch stateType {
@@ -19,9 +19,9 @@ func tableVaultSecret(_ context.Context) *plugin.Table {
 		Name:        "oci_vault_secret",
 		Description: "OCI Vault Secret",
 		Get: &plugin.GetConfig{
-			KeyColumns:        plugin.SingleColumn("id"),
-			Hydrate:           getVaultSecret,
-			ShouldIgnoreError: isNotFoundError([]string{"400", "404"}),
+			KeyColumns:   plugin.SingleColumn("id"),
+			Hydrate:      getVaultSecret,
+			IgnoreConfig: &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: isNotFoundError([]string{"400", "404"})},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listVaultSecrets,
@@ -45,7 +45,7 @@ func tableVaultSecret(_ context.Context) *plugin.Table {
 			},
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
-		Columns: []*plugin.Column{
+		Columns: CommonColumns([]*plugin.Column{
 			{
 				Name:        "name",
 				Description: "The name of the secret.",
@@ -135,40 +135,13 @@ func tableVaultSecret(_ context.Context) *plugin.Table {
 			},
 
 			// Standard Steampipe columns
-			{
-				Name:        "tags",
-				Description: ColumnDescriptionTags,
-				Type:        proto.ColumnType_JSON,
-				Transform:   transform.From(vaultSecretTags),
-			},
 			{
 				Name:        "title",
 				Description: ColumnDescriptionTitle,
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("SecretName"),
 			},
-
-			// Standard OCI columns
-			{
-				Name:        "region",
-				Description: ColumnDescriptionRegion,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("Id").Transform(ociRegionName),
-			},
-			{
-				Name:        "compartment_id",
-				Description: ColumnDescriptionCompartment,
-				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("CompartmentId"),
-			},
-			{
-				Name:        "tenant_id",
-				Description: ColumnDescriptionTenant,
-				Type:        proto.ColumnType_STRING,
-				Hydrate:     plugin.HydrateFunc(getTenantId).WithCache(),
-				Transform:   transform.FromValue(),
-			},
-		},
+		}),
 	}
 }
 
@@ -220,7 +193,7 @@ func listVaultSecrets(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydrat
 					return nil, nil
 				}
 			}
-			return nil, err
+			return nil, wrapServiceError(err, session, "ListSecrets")
 		}
 
 		for _, vault := range response.Items {
@@ -284,7 +257,7 @@ func getVaultSecret(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateD
 	response, err := session.VaultClient.GetSecret(ctx, request)
 	if err != nil {
 		logger.Error("getVaultSecret", "error_GetSecret", err)
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetSecret")
 	}
 
 	return response, nil
@@ -292,35 +265,6 @@ func getVaultSecret(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateD
 
 //// TRANSFORM FUNCTION
 
-func vaultSecretTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
-	freeformTags := vaultSecretFreeformTags(d.HydrateItem)
-
-	var tags map[string]interface{}
-
-	if freeformTags != nil {
-		tags = map[string]interface{}{}
-		for k, v := range freeformTags {
-			tags[k] = v
-		}
-	}
-
-	definedTags := vaultSecretDefinedTags(d.HydrateItem)
-
-	if definedTags != nil {
-		if tags == nil {
-			tags = map[string]interface{}{}
-		}
-		for _, v := range definedTags {
-			for key, value := range v {
-				tags[key] = value
-			}
-
-		}
-	}
-
-	return tags, nil
-}
-
 func vaultSecretFreeformTags(item interface{}) map[string]string {
 	switch item := item.(type) {
 	case vault.Secret:
@@ -2,6 +2,7 @@ package oci
 
 import (
 	"context"
+	"fmt"
 	"math"
 	"math/rand"
 	"strconv"
@@ -24,13 +25,18 @@ type nameSpace struct {
 func getNamespace(ctx context.Context, d *plugin.QueryData, region string) (*nameSpace, error) {
 	plugin.Logger(ctx).Trace("getNamespace")
 
-	cacheKey := "ObjectStorageNamespace"
+	cacheKey := fmt.Sprintf("ObjectStorageNamespace-%s", getTenancyName(ctx))
 
 	// check if the namespace is already saved in cache
 	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
 		return cachedData.(*nameSpace), nil
 	}
 
+	if name, ok := getDiskCache[*nameSpace](ctx, d, diskCacheNamespace, ""); ok {
+		d.ConnectionManager.Cache.Set(cacheKey, name)
+		return name, nil
+	}
+
 	// Create Session
 	session, err := objectStorageService(ctx, d, region)
 	if err != nil {
@@ -40,12 +46,13 @@ func getNamespace(ctx context.Context, d *plugin.QueryData, region string) (*nam
 
 	response, err := session.ObjectStorageClient.GetNamespace(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, wrapServiceError(err, session, "GetNamespace")
 	}
 	name := &nameSpace{
 		Value: *response.Value,
 	}
 	d.ConnectionManager.Cache.Set(cacheKey, name)
+	setDiskCache(ctx, d, diskCacheNamespace, "", name)
 
 	return name, err
 }
@@ -80,7 +87,8 @@ func getDefaultRetryPolicy(connection *plugin.Connection) *oci_common.RetryPolic
 			statusCode := strconv.Itoa(r.Response.HTTPResponse().StatusCode)
 			return (r.Error != nil && helpers.StringSliceContains([]string{"429", "500", "503"}, statusCode))
 		}
-		return false
+		// connection resets and timeouts have no response
+		return isRetryableNetworkError(r.Error)
 	}
 	return getExponentialBackoffRetryPolicy(attempts, minRetryDelay, retryOnResponseCodes)
 }
@@ -99,6 +107,12 @@ func getExponentialBackoffRetryPolicy(n uint, minRetryDelay time.Duration, fn fu
 		// as example (23.25ms, 63ms, 238.5ms, 607.4ms, 2s, 5.22s, 20.31s...) up to max.
 		// Maximum delay should not be more than 3 min
 		maxDelayTime := time.Duration(int(float64(int(minRetryDelay.Nanoseconds())*int(math.Pow(3, float64(r.AttemptNumber)))) * jitter))
+
+		// wait at least as long as the service asks for
+		if retryAfter, ok := getRetryAfter(r); ok && retryAfter > maxDelayTime {
+			maxDelayTime = retryAfter
+		}
+
 		if maxDelayTime > time.Duration(3*time.Minute) {
 			return time.Duration(3 * time.Minute)
 		}
@@ -0,0 +1,280 @@
+package oci
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	oci_common "github.com/oracle/oci-go-sdk/v44/common"
+)
+
+const (
+	// default locations of the Kubernetes service account credentials mounted into OKE pods
+	okeServiceAccountTokenPath = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+	okeServiceAccountCAPath    = "/var/run/secrets/kubernetes.io/serviceaccount/ca.crt"
+
+	// the OKE proxymux port which exchanges service account tokens for resource principal session tokens
+	okeProxymuxServicePort = "12250"
+
+	// session tokens are refreshed ahead of their expiry
+	securityTokenRefreshWindow = 5 * time.Minute
+)
+
+// parseSecurityTokenClaims returns the claims of a JWT security token, without verifying its signature
+func parseSecurityTokenClaims(token string) (map[string]interface{}, error) {
+	parts := strings.Split(strings.TrimPrefix(token, "ST$"), ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("security token is not a valid JWT")
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return nil, fmt.Errorf("security token is not a valid JWT: %s", err.Error())
+	}
+	claims := map[string]interface{}{}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, fmt.Errorf("security token is not a valid JWT: %s", err.Error())
+	}
+	return claims, nil
+}
+
+// getSecurityTokenExpiry returns the expiry time of a JWT security token
+func getSecurityTokenExpiry(token string) (time.Time, error) {
+	claims, err := parseSecurityTokenClaims(token)
+	if err != nil {
+		return time.Time{}, err
+	}
+	exp, ok := claims["exp"].(float64)
+	if !ok {
+		return time.Time{}, fmt.Errorf("security token has no expiry")
+	}
+	return time.Unix(int64(exp), 0), nil
+}
+
+// okeWorkloadIdentityProvider is a configuration provider for pods running in
+// OKE clusters with workload identity. The Kubernetes service account token is
+// exchanged for a resource principal session token by the proxymux service of
+// the cluster, and the session token is renewed before it expires.
+type okeWorkloadIdentityProvider struct {
+	region    string
+	endpoint  string
+	tokenPath string
+	client    oci_common.HTTPRequestDispatcher
+
+	mutex      sync.Mutex
+	privateKey *rsa.PrivateKey
+	token      string
+	claims     map[string]interface{}
+	expiresAt  time.Time
+}
+
+// newOkeWorkloadIdentityProvider returns a provider which exchanges the
+// service account token read from tokenPath at the proxymux endpoint, whose
+// certificate is signed by the CA read from caPath
+func newOkeWorkloadIdentityProvider(region string, endpoint string, tokenPath string, caPath string, clientModifier func(oci_common.HTTPRequestDispatcher) (oci_common.HTTPRequestDispatcher, error)) (*okeWorkloadIdentityProvider, error) {
+	// the proxymux service is signed by the cluster CA
+	caCert, err := os.ReadFile(caPath)
+	if err != nil {
+		return nil, fmt.Errorf("can not create OKE workload identity provider, can not read the cluster CA certificate: %s", err.Error())
+	}
+	httpClient := buildHttpClient()
+	certPool := x509.NewCertPool()
+	if !certPool.AppendCertsFromPEM(caCert) {
+		return nil, fmt.Errorf("can not create OKE workload identity provider, invalid cluster CA certificate in '%s'", caPath)
+	}
+	httpClient.Transport.(*http.Transport).TLSClientConfig.RootCAs = certPool
+
+	client, err := clientModifier(httpClient)
+	if err != nil {
+		return nil, err
+	}
+
+	if region == "" {
+		region = os.Getenv("OCI_RESOURCE_PRINCIPAL_REGION")
+	}
+
+	return &okeWorkloadIdentityProvider{
+		region:    region,
+		endpoint:  endpoint,
+		tokenPath: tokenPath,
+		client:    client,
+	}, nil
+}
+
+// getOkeProxymuxEndpoint returns the proxymux endpoint of the cluster the pod runs in
+func getOkeProxymuxEndpoint() (string, error) {
+	host := os.Getenv("KUBERNETES_SERVICE_HOST")
+	if host == "" {
+		return "", fmt.Errorf("can not create OKE workload identity provider, environment variable KUBERNETES_SERVICE_HOST is not set")
+	}
+	return fmt.Sprintf("https://%s:%s/resourcePrincipalSessionTokens", host, okeProxymuxServicePort), nil
+}
+
+// session returns the session key and the session token and claims which
+// belong to it, requesting a new token if there is none yet, or if the
+// current one is about to expire
+func (p *okeWorkloadIdentityProvider) session() (*rsa.PrivateKey, string, map[string]interface{}, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if p.token == "" || time.Until(p.expiresAt) <= securityTokenRefreshWindow {
+		if err := p.refresh(); err != nil {
+			return nil, "", nil, err
+		}
+	}
+	return p.privateKey, p.token, p.claims, nil
+}
+
+// refresh requests a new session token. The caller holds the mutex.
+func (p *okeWorkloadIdentityProvider) refresh() error {
+	serviceAccountToken, err := os.ReadFile(p.tokenPath)
+	if err != nil {
+		return fmt.Errorf("can not read the Kubernetes service account token: %s", err.Error())
+	}
+
+	// every session token is bound to a new session key
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return err
+	}
+	publicKey, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+	if err != nil {
+		return err
+	}
+
+	body, err := json.Marshal(map[string]string{"podKey": base64.StdEncoding.EncodeToString(publicKey)})
+	if err != nil {
+		return err
+	}
+	request, err := http.NewRequest(http.MethodPost, p.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("Authorization", "Bearer "+strings.TrimSpace(string(serviceAccountToken)))
+
+	response, err := p.client.Do(request)
+	if err != nil {
+		return fmt.Errorf("can not get a resource principal session token: %s", err.Error())
+	}
+	defer response.Body.Close()
+
+	responseBody, err := io.ReadAll(response.Body)
+	if err != nil {
+		return fmt.Errorf("can not get a resource principal session token: %s", err.Error())
+	}
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("can not get a resource principal session token, %s: %s", response.Status, strings.TrimSpace(string(responseBody)))
+	}
+
+	// the response is a base64 encoded JSON document holding the token
+	decoded, err := base64.StdEncoding.DecodeString(strings.Trim(strings.TrimSpace(string(responseBody)), "\""))
+	if err != nil {
+		return fmt.Errorf("can not decode the resource principal session token response: %s", err.Error())
+	}
+	var sessionToken struct {
+		Token string `json:"token"`
+	}
+	if err := json.Unmarshal(decoded, &sessionToken); err != nil {
+		return fmt.Errorf("can not decode the resource principal session token response: %s", err.Error())
+	}
+
+	token := strings.TrimPrefix(sessionToken.Token, "ST$")
+	claims, err := parseSecurityTokenClaims(token)
+	if err != nil {
+		return err
+	}
+	expiresAt, err := getSecurityTokenExpiry(token)
+	if err != nil {
+		return err
+	}
+
+	p.privateKey = privateKey
+	p.token = token
+	p.claims = claims
+	p.expiresAt = expiresAt
+	return nil
+}
+
+func (p *okeWorkloadIdentityProvider) PrivateRSAKey() (*rsa.PrivateKey, error) {
+	privateKey, _, _, err := p.session()
+	return privateKey, err
+}
+
+func (p *okeWorkloadIdentityProvider) KeyID() (string, error) {
+	_, token, _, err := p.session()
+	if err != nil {
+		return "", err
+	}
+	return "ST$" + token, nil
+}
+
+func (p *okeWorkloadIdentityProvider) TenancyOCID() (string, error) {
+	_, _, claims, err := p.session()
+	if err != nil {
+		return "", err
+	}
+	tenancy, ok := claims["res_tenant"].(string)
+	if !ok {
+		return "", fmt.Errorf("resource principal session token has no tenancy claim")
+	}
+	return tenancy, nil
+}
+
+func (p *okeWorkloadIdentityProvider) UserOCID() (string, error) {
+	return "", nil
+}
+
+func (p *okeWorkloadIdentityProvider) KeyFingerprint() (string, error) {
+	return "", nil
+}
+
+func (p *okeWorkloadIdentityProvider) Region() (string, error) {
+	if p.region == "" {
+		return "", fmt.Errorf("region must be set for OKE workload identity authentication")
+	}
+	return p.region, nil
+}
+
+func (p *okeWorkloadIdentityProvider) AuthType() (oci_common.AuthConfig, error) {
+	return oci_common.AuthConfig{AuthType: oci_common.UnknownAuthenticationType}, fmt.Errorf("unsupported, keep the interface")
+}
+
+// okeWorkloadIdentitySigner signs requests with a session key and the token of
+// the same session. The SDK signer reads the key and the token with separate
+// calls, so a refresh between them would sign with the key of one session and
+// the token of another.
+type okeWorkloadIdentitySigner struct {
+	provider *okeWorkloadIdentityProvider
+}
+
+func (s okeWorkloadIdentitySigner) Sign(request *http.Request) error {
+	privateKey, token, _, err := s.provider.session()
+	if err != nil {
+		return err
+	}
+	return oci_common.DefaultRequestSigner(sessionKeyProvider{privateKey: privateKey, keyID: "ST$" + token}).Sign(request)
+}
+
+// sessionKeyProvider is the key provider of a single session
+type sessionKeyProvider struct {
+	privateKey *rsa.PrivateKey
+	keyID      string
+}
+
+func (p sessionKeyProvider) PrivateRSAKey() (*rsa.PrivateKey, error) {
+	return p.privateKey, nil
+}
+
+func (p sessionKeyProvider) KeyID() (string, error) {
+	return p.keyID, nil
+}
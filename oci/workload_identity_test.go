@@ -0,0 +1,220 @@
+package oci
+
+import (
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	oci_common "github.com/oracle/oci-go-sdk/v44/common"
+)
+
+const testTenancyOCID = "ocid1.tenancy.oc1..aaaaaaaatest"
+
+// fakeProxymux is a local proxymux service, which issues session tokens for
+// the pod keys of its requests
+type fakeProxymux struct {
+	server *httptest.Server
+	// lifetime of the issued tokens
+	lifetime time.Duration
+	// status of the responses, 200 if unset
+	status int
+
+	mutex sync.Mutex
+	// pod keys of the issued tokens, by token
+	podKeys map[string]string
+}
+
+func newFakeProxymux(t *testing.T, lifetime time.Duration) *fakeProxymux {
+	proxymux := &fakeProxymux{lifetime: lifetime, podKeys: map[string]string{}}
+	proxymux.server = httptest.NewTLSServer(http.HandlerFunc(proxymux.serveHTTP))
+	t.Cleanup(proxymux.server.Close)
+	return proxymux
+}
+
+func (f *fakeProxymux) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost || r.URL.Path != "/resourcePrincipalSessionTokens" {
+		http.NotFound(w, r)
+		return
+	}
+	if r.Header.Get("Authorization") != "Bearer service-account-token" {
+		http.Error(w, "invalid service account token", http.StatusUnauthorized)
+		return
+	}
+	if f.status != 0 {
+		http.Error(w, "proxymux unavailable", f.status)
+		return
+	}
+
+	var body struct {
+		PodKey string `json:"podKey"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body.PodKey == "" {
+		http.Error(w, "invalid pod key", http.StatusBadRequest)
+		return
+	}
+
+	f.mutex.Lock()
+	claims, _ := json.Marshal(map[string]interface{}{
+		"exp":        time.Now().Add(f.lifetime).Unix(),
+		"res_tenant": testTenancyOCID,
+		"jti":        fmt.Sprintf("token-%d", len(f.podKeys)),
+	})
+	token := "eyJhbGciOiJSUzI1NiJ9." + base64.RawURLEncoding.EncodeToString(claims) + ".signature"
+	f.podKeys[token] = body.PodKey
+	f.mutex.Unlock()
+
+	response, _ := json.Marshal(map[string]string{"token": "ST$" + token})
+	fmt.Fprintf(w, "%q", base64.StdEncoding.EncodeToString(response))
+}
+
+func (f *fakeProxymux) issued() int {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return len(f.podKeys)
+}
+
+func (f *fakeProxymux) podKey(token string) string {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return f.podKeys[token]
+}
+
+// newTestOkeWorkloadIdentityProvider returns a provider of the fake proxymux,
+// with the service account token and the CA certificate in a temporary directory
+func newTestOkeWorkloadIdentityProvider(t *testing.T, proxymux *fakeProxymux) *okeWorkloadIdentityProvider {
+	dir := t.TempDir()
+	tokenPath := filepath.Join(dir, "token")
+	if err := os.WriteFile(tokenPath, []byte("service-account-token\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	caPath := filepath.Join(dir, "ca.crt")
+	caCert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: proxymux.server.Certificate().Raw})
+	if err := os.WriteFile(caPath, caCert, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	noModifier := func(client oci_common.HTTPRequestDispatcher) (oci_common.HTTPRequestDispatcher, error) {
+		return client, nil
+	}
+	provider, err := newOkeWorkloadIdentityProvider("us-ashburn-1", proxymux.server.URL+"/resourcePrincipalSessionTokens", tokenPath, caPath, noModifier)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return provider
+}
+
+// assertSessionKey fails if the private key is not the pod key the token was issued for
+func assertSessionKey(t *testing.T, proxymux *fakeProxymux, privateKey *rsa.PrivateKey, token string) {
+	t.Helper()
+	publicKey, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if proxymux.podKey(token) != base64.StdEncoding.EncodeToString(publicKey) {
+		t.Errorf("session key does not belong to token %s", token)
+	}
+}
+
+func TestOkeWorkloadIdentityProvider(t *testing.T) {
+	proxymux := newFakeProxymux(t, time.Hour)
+	provider := newTestOkeWorkloadIdentityProvider(t, proxymux)
+
+	tenancy, err := provider.TenancyOCID()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tenancy != testTenancyOCID {
+		t.Errorf("TenancyOCID() = %s, want %s", tenancy, testTenancyOCID)
+	}
+
+	keyID, err := provider.KeyID()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(keyID, "ST$") {
+		t.Errorf("KeyID() = %s, want a security token", keyID)
+	}
+	privateKey, err := provider.PrivateRSAKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertSessionKey(t, proxymux, privateKey, strings.TrimPrefix(keyID, "ST$"))
+
+	// tokens are reused until they are about to expire
+	if issued := proxymux.issued(); issued != 1 {
+		t.Errorf("proxymux issued %d tokens, want 1", issued)
+	}
+}
+
+func TestOkeWorkloadIdentityProviderRefresh(t *testing.T) {
+	// tokens which expire within the refresh window are renewed on every use
+	proxymux := newFakeProxymux(t, securityTokenRefreshWindow/2)
+	provider := newTestOkeWorkloadIdentityProvider(t, proxymux)
+
+	for i := 0; i < 3; i++ {
+		if _, err := provider.KeyID(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if issued := proxymux.issued(); issued != 3 {
+		t.Errorf("proxymux issued %d tokens, want 3", issued)
+	}
+}
+
+func TestOkeWorkloadIdentitySigner(t *testing.T) {
+	proxymux := newFakeProxymux(t, securityTokenRefreshWindow/2)
+	provider := newTestOkeWorkloadIdentityProvider(t, proxymux)
+	signer := okeWorkloadIdentitySigner{provider: provider}
+
+	// every signature uses the key of the token it is sent with, while tokens are renewed concurrently
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			request, err := http.NewRequest(http.MethodGet, "https://iaas.us-ashburn-1.oraclecloud.com/20160918/vcns", nil)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			request.Header.Set("Date", time.Now().UTC().Format(http.TimeFormat))
+			if err := signer.Sign(request); err != nil {
+				t.Error(err)
+				return
+			}
+			if !strings.Contains(request.Header.Get("Authorization"), `keyId="ST$`) {
+				t.Errorf("request is not signed with a security token: %s", request.Header.Get("Authorization"))
+			}
+
+			privateKey, token, _, err := provider.session()
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			assertSessionKey(t, proxymux, privateKey, token)
+		}()
+	}
+	wg.Wait()
+}
+
+func TestOkeWorkloadIdentityProviderError(t *testing.T) {
+	proxymux := newFakeProxymux(t, time.Hour)
+	proxymux.status = http.StatusServiceUnavailable
+	provider := newTestOkeWorkloadIdentityProvider(t, proxymux)
+
+	_, err := provider.KeyID()
+	if err == nil || !strings.Contains(err.Error(), "503") {
+		t.Errorf("KeyID() error = %v, want the status of the proxymux response", err)
+	}
+}